@@ -0,0 +1,189 @@
+package countries
+
+import (
+	"sort"
+	"strings"
+)
+
+// BlocInfo is a regional bloc of the catalog, with the alpha-3 codes of its members.
+type BlocInfo struct {
+	Acronym       string
+	Name          string
+	OtherAcronyms []string
+	OtherNames    []string
+	Members       []string
+}
+
+// BlocOverlap is the set of countries belonging to two regional blocs.
+type BlocOverlap struct {
+	First   string
+	Second  string
+	Members []string
+}
+
+// BlocCatalog indexes the regional blocs of a set of countries by acronym.
+type BlocCatalog struct {
+	blocs     map[string]*BlocInfo
+	aliases   map[string]string
+	countries map[string]Country
+}
+
+// NewBlocCatalog returns a new BlocCatalog built from the RegionalBlocs of the given countries.
+func NewBlocCatalog(countries []Country) *BlocCatalog {
+	catalog := &BlocCatalog{
+		blocs:     map[string]*BlocInfo{},
+		aliases:   map[string]string{},
+		countries: make(map[string]Country, len(countries)),
+	}
+	for _, c := range countries {
+		catalog.countries[strings.ToUpper(c.Alpha3Code)] = c
+		for _, b := range c.RegionalBlocs {
+			key := strings.ToUpper(strings.TrimSpace(b.Acronym))
+			if key == "" {
+				continue
+			}
+			info, ok := catalog.blocs[key]
+			if !ok {
+				info = &BlocInfo{Acronym: key, Name: b.Name}
+				catalog.blocs[key] = info
+			}
+			info.OtherAcronyms = appendMissing(info.OtherAcronyms, b.OtherAcronyms...)
+			info.OtherNames = appendMissing(info.OtherNames, b.OtherNames...)
+			if !containsCode(info.Members, c.Alpha3Code) {
+				info.Members = append(info.Members, c.Alpha3Code)
+			}
+		}
+	}
+
+	// Acronyms win over names when an alias is claimed by several blocs.
+	for key, info := range catalog.blocs {
+		sort.Strings(info.Members)
+		catalog.aliases[fold(key)] = key
+	}
+	for _, key := range catalog.acronyms() {
+		info := catalog.blocs[key]
+		for _, alias := range append(append([]string{info.Name}, info.OtherAcronyms...), info.OtherNames...) {
+			if a := fold(alias); a != "" {
+				if _, taken := catalog.aliases[a]; !taken {
+					catalog.aliases[a] = key
+				}
+			}
+		}
+	}
+
+	return catalog
+}
+
+// Bloc returns the regional bloc identified by its acronym, one of its other
+// acronyms, its name or one of its other names (e.g. UNASUR for USAN).
+// Matching ignores case and accents.
+func (c *BlocCatalog) Bloc(id string) (BlocInfo, bool) {
+	info, ok := c.blocs[c.aliases[fold(id)]]
+	if !ok {
+		return BlocInfo{}, false
+	}
+
+	return *info, true
+}
+
+// Blocs returns every regional bloc of the catalog, sorted by acronym.
+func (c *BlocCatalog) Blocs() []BlocInfo {
+	blocs := make([]BlocInfo, 0, len(c.blocs))
+	for _, key := range c.acronyms() {
+		blocs = append(blocs, *c.blocs[key])
+	}
+
+	return blocs
+}
+
+// Members returns the member countries of the regional bloc, sorted by code.
+func (c *BlocCatalog) Members(id string) []Country {
+	info, ok := c.Bloc(id)
+	if !ok {
+		return nil
+	}
+
+	members := make([]Country, len(info.Members))
+	for i, code := range info.Members {
+		members[i] = c.countries[strings.ToUpper(code)]
+	}
+
+	return members
+}
+
+// CommonBlocs returns the regional blocs both countries belong to, sorted by acronym.
+func (c *BlocCatalog) CommonBlocs(first, second string) []BlocInfo {
+	var common []BlocInfo
+	for _, info := range c.Blocs() {
+		if containsCode(info.Members, first) && containsCode(info.Members, second) {
+			common = append(common, info)
+		}
+	}
+
+	return common
+}
+
+// Overlap returns the alpha-3 codes of the countries belonging to both regional blocs, sorted.
+func (c *BlocCatalog) Overlap(first, second string) []string {
+	a, ok := c.Bloc(first)
+	if !ok {
+		return nil
+	}
+	b, ok := c.Bloc(second)
+	if !ok {
+		return nil
+	}
+
+	var members []string
+	for _, code := range a.Members {
+		if containsCode(b.Members, code) {
+			members = append(members, code)
+		}
+	}
+
+	return members
+}
+
+// Overlaps returns every pair of regional blocs sharing at least one member,
+// sorted by acronyms.
+func (c *BlocCatalog) Overlaps() []BlocOverlap {
+	var overlaps []BlocOverlap
+	acronyms := c.acronyms()
+	for i, first := range acronyms {
+		for _, second := range acronyms[i+1:] {
+			if members := c.Overlap(first, second); len(members) > 0 {
+				overlaps = append(overlaps, BlocOverlap{First: first, Second: second, Members: members})
+			}
+		}
+	}
+
+	return overlaps
+}
+
+func (c *BlocCatalog) acronyms() []string {
+	acronyms := make([]string, 0, len(c.blocs))
+	for key := range c.blocs {
+		acronyms = append(acronyms, key)
+	}
+	sort.Strings(acronyms)
+
+	return acronyms
+}
+
+// appendMissing appends the values not already in the list, ignoring empty ones.
+func appendMissing(list []string, values ...string) []string {
+	for _, v := range values {
+		found := v == ""
+		for _, l := range list {
+			if l == v {
+				found = true
+				break
+			}
+		}
+		if !found {
+			list = append(list, v)
+		}
+	}
+
+	return list
+}
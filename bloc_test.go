@@ -0,0 +1,87 @@
+package countries_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/georgesafta/countries"
+)
+
+func TestBlocCatalogLookup(t *testing.T) {
+	catalog := countries.NewBlocCatalog(loadDataset(t))
+
+	for _, id := range []string{"USAN", "usan", "UNASUR", "Union of South American Nations", "Unión de Naciones Suramericanas", "union de naciones suramericanas"} {
+		bloc, ok := catalog.Bloc(id)
+		if !ok || bloc.Acronym != "USAN" {
+			t.Fatalf("Expected USAN for %q, got %v", id, bloc)
+		}
+	}
+	if _, ok := catalog.Bloc("ASEAN"); ok {
+		t.Fatal("Expected unknown bloc")
+	}
+
+	var acronyms []string
+	for _, b := range catalog.Blocs() {
+		acronyms = append(acronyms, b.Acronym)
+	}
+	if !reflect.DeepEqual([]string{"AU", "CAIS", "EFTA", "EU", "NAFTA", "PA", "SAARC", "USAN"}, acronyms) {
+		t.Fatalf("Unexpected blocs %v", acronyms)
+	}
+}
+
+func TestBlocCatalogMembers(t *testing.T) {
+	catalog := countries.NewBlocCatalog(loadDataset(t))
+
+	var codes []string
+	for _, c := range catalog.Members("Alianza del Pacífico") {
+		codes = append(codes, c.Alpha3Code)
+	}
+	if !reflect.DeepEqual([]string{"COL", "MEX", "PER"}, codes) {
+		t.Fatalf("Unexpected Pacific Alliance members %v", codes)
+	}
+	if catalog.Members("ASEAN") != nil {
+		t.Fatal("Expected no members for unknown bloc")
+	}
+}
+
+func TestBlocCatalogCommonBlocs(t *testing.T) {
+	catalog := countries.NewBlocCatalog(loadDataset(t))
+
+	var acronyms []string
+	for _, b := range catalog.CommonBlocs("COL", "PER") {
+		acronyms = append(acronyms, b.Acronym)
+	}
+	if !reflect.DeepEqual([]string{"PA", "USAN"}, acronyms) {
+		t.Fatalf("Unexpected common blocs %v", acronyms)
+	}
+	if len(catalog.CommonBlocs("COL", "JPN")) != 0 {
+		t.Fatal("Expected no common blocs")
+	}
+}
+
+func TestBlocCatalogOverlaps(t *testing.T) {
+	catalog := countries.NewBlocCatalog(loadDataset(t))
+
+	if got := catalog.Overlap("UNASUR", "PA"); !reflect.DeepEqual([]string{"COL", "PER"}, got) {
+		t.Fatalf("Unexpected overlap %v", got)
+	}
+	expected := []countries.BlocOverlap{
+		{First: "NAFTA", Second: "PA", Members: []string{"MEX"}},
+		{First: "PA", Second: "USAN", Members: []string{"COL", "PER"}},
+	}
+	if got := catalog.Overlaps(); !reflect.DeepEqual(expected, got) {
+		t.Fatalf("Expected overlaps %v, got %v", expected, got)
+	}
+}
+
+func TestBlocCatalogMerge(t *testing.T) {
+	catalog := countries.NewBlocCatalog([]countries.Country{
+		{Alpha3Code: "AAA", RegionalBlocs: []countries.RegionalBloc{{Acronym: "EU", Name: "European Union", OtherNames: []string{"Union européenne"}}}},
+		{Alpha3Code: "BBB", RegionalBlocs: []countries.RegionalBloc{{Acronym: "eu", Name: "European Union", OtherNames: []string{"Europäische Union"}}}},
+	})
+
+	eu, ok := catalog.Bloc("Europäische Union")
+	if !ok || !reflect.DeepEqual([]string{"AAA", "BBB"}, eu.Members) || len(eu.OtherNames) != 2 {
+		t.Fatalf("Expected merged bloc, got %v", eu)
+	}
+}
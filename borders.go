@@ -0,0 +1,217 @@
+package countries
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// BorderPair is a border listed by Country towards Border.
+type BorderPair struct {
+	Country string
+	Border  string
+}
+
+// BorderGraph is the land border graph of a set of countries, keyed by ISO 3166 alpha-3 code.
+// A border listed by only one of the two countries is still treated as an edge,
+// use Asymmetries to find them.
+type BorderGraph struct {
+	codes     []string
+	countries map[string]Country
+	edges     map[string]map[string]bool
+}
+
+// NewBorderGraph returns a new BorderGraph built from the Borders of the given countries.
+// Borders towards countries missing from the list are ignored.
+func NewBorderGraph(countries []Country) *BorderGraph {
+	g := &BorderGraph{
+		countries: make(map[string]Country, len(countries)),
+		edges:     make(map[string]map[string]bool, len(countries)),
+	}
+	for _, c := range countries {
+		code := strings.ToUpper(c.Alpha3Code)
+		g.codes = append(g.codes, code)
+		g.countries[code] = c
+		g.edges[code] = map[string]bool{}
+	}
+	sort.Strings(g.codes)
+	for code, c := range g.countries {
+		for _, b := range c.Borders {
+			border := strings.ToUpper(b)
+			if _, ok := g.countries[border]; ok && border != code {
+				g.edges[code][border] = true
+				g.edges[border][code] = true
+			}
+		}
+	}
+
+	return g
+}
+
+// Neighbors returns the countries sharing a land border with the given country, sorted by code.
+func (g *BorderGraph) Neighbors(code string) []Country {
+	codes := sortedKeys(g.edges[strings.ToUpper(code)])
+	neighbors := make([]Country, len(codes))
+	for i, c := range codes {
+		neighbors[i] = g.countries[c]
+	}
+
+	return neighbors
+}
+
+// Path returns the shortest land path between two countries, as the list of
+// alpha-3 codes crossed including both ends.
+// Returns an error when a country is unknown or cannot be reached by land.
+func (g *BorderGraph) Path(from, to string) ([]string, error) {
+	from, to = strings.ToUpper(from), strings.ToUpper(to)
+	for _, code := range []string{from, to} {
+		if _, ok := g.countries[code]; !ok {
+			return nil, fmt.Errorf("Unknown country code %s", code)
+		}
+	}
+
+	previous := map[string]string{from: ""}
+	queue := []string{from}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		if current == to {
+			var path []string
+			for c := to; c != ""; c = previous[c] {
+				path = append([]string{c}, path...)
+			}
+			return path, nil
+		}
+		for _, next := range sortedKeys(g.edges[current]) {
+			if _, seen := previous[next]; !seen {
+				previous[next] = current
+				queue = append(queue, next)
+			}
+		}
+	}
+
+	return nil, fmt.Errorf("No land path between %s and %s", from, to)
+}
+
+// Neighborhood returns the codes of the countries reachable by crossing at most
+// hops land borders, excluding the country itself.
+// Codes are sorted by distance, then alphabetically.
+func (g *BorderGraph) Neighborhood(code string, hops int) []string {
+	code = strings.ToUpper(code)
+	if _, ok := g.countries[code]; !ok {
+		return nil
+	}
+
+	var result []string
+	seen := map[string]bool{code: true}
+	frontier := []string{code}
+	for hop := 0; hop < hops && len(frontier) > 0; hop++ {
+		var next []string
+		for _, c := range frontier {
+			for _, n := range sortedKeys(g.edges[c]) {
+				if !seen[n] {
+					seen[n] = true
+					next = append(next, n)
+				}
+			}
+		}
+		sort.Strings(next)
+		result = append(result, next...)
+		frontier = next
+	}
+
+	return result
+}
+
+// Islands returns the codes of the countries that list no land border, sorted.
+func (g *BorderGraph) Islands() []string {
+	var islands []string
+	for code, c := range g.countries {
+		if len(c.Borders) == 0 {
+			islands = append(islands, code)
+		}
+	}
+	sort.Strings(islands)
+
+	return islands
+}
+
+// Components returns the groups of countries connected by land, largest first.
+// Codes within a group are sorted.
+func (g *BorderGraph) Components() [][]string {
+	var components [][]string
+	seen := map[string]bool{}
+	for _, start := range g.codes {
+		if seen[start] {
+			continue
+		}
+		seen[start] = true
+		component := []string{start}
+		for i := 0; i < len(component); i++ {
+			for n := range g.edges[component[i]] {
+				if !seen[n] {
+					seen[n] = true
+					component = append(component, n)
+				}
+			}
+		}
+		sort.Strings(component)
+		components = append(components, component)
+	}
+	sort.SliceStable(components, func(i, j int) bool {
+		return len(components[i]) > len(components[j])
+	})
+
+	return components
+}
+
+// Asymmetries returns the borders listed by a country but not by its neighbor,
+// when both countries are known.
+func (g *BorderGraph) Asymmetries() []BorderPair {
+	var pairs []BorderPair
+	for _, code := range g.codes {
+		for _, b := range g.countries[code].Borders {
+			border := strings.ToUpper(b)
+			neighbor, ok := g.countries[border]
+			if ok && !containsCode(neighbor.Borders, code) {
+				pairs = append(pairs, BorderPair{Country: code, Border: border})
+			}
+		}
+	}
+
+	return pairs
+}
+
+// UnknownBorders returns the borders pointing to countries missing from the graph.
+func (g *BorderGraph) UnknownBorders() []BorderPair {
+	var pairs []BorderPair
+	for _, code := range g.codes {
+		for _, b := range g.countries[code].Borders {
+			if _, ok := g.countries[strings.ToUpper(b)]; !ok {
+				pairs = append(pairs, BorderPair{Country: code, Border: strings.ToUpper(b)})
+			}
+		}
+	}
+
+	return pairs
+}
+
+func containsCode(codes []string, code string) bool {
+	for _, c := range codes {
+		if strings.EqualFold(c, code) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func sortedKeys(m map[string]bool) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
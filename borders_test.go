@@ -0,0 +1,112 @@
+package countries_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/georgesafta/countries"
+)
+
+func TestBorderGraphNeighbors(t *testing.T) {
+	graph := countries.NewBorderGraph(loadDataset(t))
+
+	var codes []string
+	for _, c := range graph.Neighbors("col") {
+		codes = append(codes, c.Alpha3Code)
+	}
+	expected := []string{"BRA", "ECU", "PAN", "PER", "VEN"}
+	if !reflect.DeepEqual(expected, codes) {
+		t.Fatalf("Expected neighbors %v, got %v", expected, codes)
+	}
+	if len(graph.Neighbors("JPN")) != 0 || len(graph.Neighbors("XXX")) != 0 {
+		t.Fatal("Expected no neighbors")
+	}
+}
+
+func TestBorderGraphPath(t *testing.T) {
+	graph := countries.NewBorderGraph(loadDataset(t))
+
+	path, err := graph.Path("PRT", "AUT")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	expected := []string{"PRT", "ESP", "FRA", "CHE", "AUT"}
+	if !reflect.DeepEqual(expected, path) {
+		t.Fatalf("Expected path %v, got %v", expected, path)
+	}
+
+	path, err = graph.Path("COL", "COL")
+	if err != nil || !reflect.DeepEqual([]string{"COL"}, path) {
+		t.Fatalf("Expected single country path, got %v, %v", path, err)
+	}
+
+	_, err = graph.Path("PRT", "CHN")
+	if err == nil || err.Error() != "No land path between PRT and CHN" {
+		t.Fatalf("Expected no path error, got %v", err)
+	}
+
+	_, err = graph.Path("PRT", "XXX")
+	if err == nil || err.Error() != "Unknown country code XXX" {
+		t.Fatalf("Expected unknown code error, got %v", err)
+	}
+}
+
+func TestBorderGraphNeighborhood(t *testing.T) {
+	graph := countries.NewBorderGraph(loadDataset(t))
+
+	expected := []string{"BRA", "ECU", "PAN", "PER", "VEN", "BOL"}
+	if got := graph.Neighborhood("COL", 2); !reflect.DeepEqual(expected, got) {
+		t.Fatalf("Expected neighborhood %v, got %v", expected, got)
+	}
+	if got := graph.Neighborhood("COL", 0); len(got) != 0 {
+		t.Fatalf("Expected empty neighborhood, got %v", got)
+	}
+}
+
+func TestBorderGraphIslandsAndComponents(t *testing.T) {
+	graph := countries.NewBorderGraph(loadDataset(t))
+
+	expectedIslands := []string{"ATA", "AUS", "JPN", "PRI", "TUV"}
+	if got := graph.Islands(); !reflect.DeepEqual(expectedIslands, got) {
+		t.Fatalf("Expected islands %v, got %v", expectedIslands, got)
+	}
+
+	components := graph.Components()
+	expected := [][]string{
+		{"BOL", "BRA", "COL", "ECU", "PAN", "PER", "VEN"},
+		{"AUT", "CHE", "DEU", "ESP", "FRA", "PRT"},
+		{"CAN", "MEX", "USA"},
+		{"CHN", "IND", "NPL"},
+		{"GBR", "IRL"},
+	}
+	if !reflect.DeepEqual(expected, components[:len(expected)]) {
+		t.Fatalf("Expected components %v, got %v", expected, components)
+	}
+	for _, c := range components[len(expected):] {
+		if len(c) != 1 {
+			t.Fatalf("Expected isolated countries, got %v", c)
+		}
+	}
+}
+
+func TestBorderGraphSymmetry(t *testing.T) {
+	if got := countries.NewBorderGraph(loadDataset(t)).Asymmetries(); len(got) != 0 {
+		t.Fatalf("Expected symmetric dataset, got %v", got)
+	}
+
+	graph := countries.NewBorderGraph([]countries.Country{
+		{Alpha3Code: "AAA", Borders: []string{"BBB", "ZZZ"}},
+		{Alpha3Code: "BBB"},
+	})
+	expected := []countries.BorderPair{{Country: "AAA", Border: "BBB"}}
+	if got := graph.Asymmetries(); !reflect.DeepEqual(expected, got) {
+		t.Fatalf("Expected asymmetries %v, got %v", expected, got)
+	}
+	expected = []countries.BorderPair{{Country: "AAA", Border: "ZZZ"}}
+	if got := graph.UnknownBorders(); !reflect.DeepEqual(expected, got) {
+		t.Fatalf("Expected unknown borders %v, got %v", expected, got)
+	}
+	if _, err := graph.Path("BBB", "AAA"); err != nil {
+		t.Fatalf("Expected one sided border to be crossable, got %v", err)
+	}
+}
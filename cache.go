@@ -0,0 +1,307 @@
+package countries
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+)
+
+// DefaultCacheTTL is the time responses stay fresh in a DiskCache by default.
+const DefaultCacheTTL = 24 * time.Hour
+
+// CacheEntry is a cached API response.
+type CacheEntry struct {
+	Data    []byte
+	Stored  time.Time
+	Expires time.Time
+}
+
+// Expired reports whether the entry is expired at the given time.
+func (e *CacheEntry) Expired(now time.Time) bool {
+	return !now.Before(e.Expires)
+}
+
+// Cache stores the API responses of an HTTPClient, keyed by endpoint with the
+// fields filter. The HTTPClient serves fresh entries without calling the API,
+// and expired ones when the API fails with a network error or a 5xx status.
+type Cache interface {
+	// Get returns the entry of the key, or nil when there is none to serve.
+	Get(key string) (*CacheEntry, error)
+	// Set stores the response of the key.
+	Set(key string, data []byte) error
+}
+
+// DiskCache is a Cache persisted in a single file, shared by every process
+// using the same path.
+//
+// Entries are appended to the file, so updated and expired entries use space
+// until Compact rewrites it. Accesses are serialized with a lock on a sibling
+// .lock file, which is a no-op on platforms without file locking. A partial
+// entry left by an interrupted write is ignored and overwritten.
+type DiskCache struct {
+	// TTL is the time entries stay fresh.
+	TTL time.Duration
+	// StaleIfError keeps expired entries, so the HTTPClient serves them when
+	// the API is down. Otherwise expired entries are never returned.
+	StaleIfError bool
+	path         string
+	mu           sync.Mutex
+	index        map[string]cacheRecord
+	indexed      os.FileInfo
+	size         int64
+}
+
+// cacheHeader precedes the data of an entry in the file, on its own line.
+type cacheHeader struct {
+	Key     string    `json:"key"`
+	Stored  time.Time `json:"stored"`
+	Expires time.Time `json:"expires"`
+	Size    int       `json:"size"`
+}
+
+// cacheRecord locates the latest data of a key in the file.
+type cacheRecord struct {
+	cacheHeader
+	offset int64
+}
+
+// NewDiskCache returns a new DiskCache stored at path.
+// The file and its directory are created on the first Set.
+func NewDiskCache(path string) *DiskCache {
+	return &DiskCache{
+		TTL:   DefaultCacheTTL,
+		path:  path,
+		index: map[string]cacheRecord{},
+	}
+}
+
+// Get returns the entry of the key. Expired entries are only returned with StaleIfError.
+func (c *DiskCache) Get(key string) (*CacheEntry, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	unlock, err := c.lock(false)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	f, err := os.Open(c.path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	if err := c.refresh(f); err != nil {
+		return nil, err
+	}
+
+	r, ok := c.index[key]
+	if !ok {
+		return nil, nil
+	}
+	entry := &CacheEntry{Stored: r.Stored, Expires: r.Expires}
+	if entry.Expired(time.Now()) && !c.StaleIfError {
+		return nil, nil
+	}
+	entry.Data = make([]byte, r.Size)
+	if _, err := f.ReadAt(entry.Data, r.offset); err != nil {
+		return nil, err
+	}
+
+	return entry, nil
+}
+
+// Set stores the data of the key, fresh for the TTL.
+func (c *DiskCache) Set(key string, data []byte) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	unlock, err := c.lock(true)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	f, err := os.OpenFile(c.path, os.O_RDWR|os.O_CREATE, 0644)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	if err := c.refresh(f); err != nil {
+		return err
+	}
+	// Drops a partial entry at the end of the file.
+	if err := f.Truncate(c.size); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	r := cacheRecord{cacheHeader: cacheHeader{Key: key, Stored: now, Expires: now.Add(c.TTL), Size: len(data)}}
+	record, err := encodeCacheRecord(&r, data)
+	if err != nil {
+		return err
+	}
+	if _, err := f.WriteAt(record, c.size); err != nil {
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		return err
+	}
+	r.offset = c.size + int64(len(record)-len(data)-1)
+	c.index[key] = r
+	c.size += int64(len(record))
+	c.indexed, err = f.Stat()
+
+	return err
+}
+
+// Compact rewrites the file with the latest entry of every key, dropping the
+// expired ones unless StaleIfError is set.
+func (c *DiskCache) Compact() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	unlock, err := c.lock(true)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	f, err := os.Open(c.path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	if err := c.refresh(f); err != nil {
+		return err
+	}
+
+	tmp, err := ioutil.TempFile(filepath.Dir(c.path), "."+filepath.Base(c.path)+"-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	w := bufio.NewWriter(tmp)
+	now := time.Now()
+	keys := make([]string, 0, len(c.index))
+	for key := range c.index {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		r := c.index[key]
+		if !now.Before(r.Expires) && !c.StaleIfError {
+			continue
+		}
+		data := make([]byte, r.Size)
+		if _, err := f.ReadAt(data, r.offset); err != nil {
+			tmp.Close()
+			return err
+		}
+		record, err := encodeCacheRecord(&r, data)
+		if err != nil {
+			tmp.Close()
+			return err
+		}
+		w.Write(record)
+	}
+	if err := w.Flush(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	f.Close()
+	if err := os.Rename(tmp.Name(), c.path); err != nil {
+		return err
+	}
+	c.indexed = nil
+
+	return nil
+}
+
+// lock takes the file lock shared by the processes using the cache.
+func (c *DiskCache) lock(exclusive bool) (func(), error) {
+	if exclusive {
+		if err := os.MkdirAll(filepath.Dir(c.path), 0755); err != nil {
+			return nil, err
+		}
+	}
+	f, err := os.OpenFile(c.path+".lock", os.O_RDWR|os.O_CREATE, 0644)
+	if os.IsNotExist(err) && !exclusive {
+		return func() {}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := lockFile(f, exclusive); err != nil {
+		f.Close()
+		return nil, fmt.Errorf("Cannot lock the cache: %v", err)
+	}
+
+	return func() {
+		unlockFile(f)
+		f.Close()
+	}, nil
+}
+
+// refresh indexes the entries written since the last call, or the whole file
+// when another process replaced or truncated it.
+func (c *DiskCache) refresh(f *os.File) error {
+	info, err := f.Stat()
+	if err != nil {
+		return err
+	}
+	if c.indexed == nil || !os.SameFile(c.indexed, info) || info.Size() < c.size {
+		c.index = map[string]cacheRecord{}
+		c.size = 0
+	}
+	c.indexed = info
+
+	r := bufio.NewReader(io.NewSectionReader(f, c.size, info.Size()-c.size))
+	for {
+		line, err := r.ReadBytes('\n')
+		if err != nil {
+			return nil
+		}
+		record := cacheRecord{}
+		if err := json.Unmarshal(line, &record.cacheHeader); err != nil || record.Size < 0 {
+			return nil
+		}
+		if n, err := r.Discard(record.Size + 1); err != nil || n != record.Size+1 {
+			return nil
+		}
+		record.offset = c.size + int64(len(line))
+		c.index[record.Key] = record
+		c.size = record.offset + int64(record.Size) + 1
+	}
+}
+
+func encodeCacheRecord(r *cacheRecord, data []byte) ([]byte, error) {
+	header, err := json.Marshal(r.cacheHeader)
+	if err != nil {
+		return nil, err
+	}
+	record := make([]byte, 0, len(header)+len(data)+2)
+	record = append(record, header...)
+	record = append(record, '\n')
+	record = append(record, data...)
+
+	return append(record, '\n'), nil
+}
@@ -0,0 +1,249 @@
+package countries_test
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/georgesafta/countries"
+)
+
+func newDiskCache(t *testing.T) (*countries.DiskCache, string) {
+	dir, err := ioutil.TempDir("", "cache")
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(dir, "cache", "countries.db")
+
+	return countries.NewDiskCache(path), path
+}
+
+func TestDiskCache(t *testing.T) {
+	cache, path := newDiskCache(t)
+	defer os.RemoveAll(filepath.Dir(filepath.Dir(path)))
+
+	if entry, err := cache.Get("/all"); entry != nil || err != nil {
+		t.Fatalf("Expected no entry, got %v, %v", entry, err)
+	}
+	for _, data := range []string{"[1]", "[1,\n2]", "[]"} {
+		if err := cache.Set("/all", []byte(data)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := cache.Set("/alpha/col?fields=name", []byte(`[{"name":"Colombia"}]`)); err != nil {
+		t.Fatal(err)
+	}
+
+	// Another process sees the entries written so far.
+	other := countries.NewDiskCache(path)
+	for key, expected := range map[string]string{"/all": "[]", "/alpha/col?fields=name": `[{"name":"Colombia"}]`} {
+		entry, err := other.Get(key)
+		if err != nil || entry == nil || string(entry.Data) != expected {
+			t.Fatalf("Expected %s for %s, got %v, %v", expected, key, entry, err)
+		}
+		if entry.Expired(time.Now()) || entry.Expires.Sub(entry.Stored) != countries.DefaultCacheTTL {
+			t.Fatalf("Expected a fresh entry for the default TTL, got %v", entry)
+		}
+	}
+
+	// A partial write is ignored and overwritten.
+	f, _ := os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0644)
+	f.WriteString(`{"key":"/region/europe","size":100}` + "\n[")
+	f.Close()
+	if entry, err := other.Get("/region/europe"); entry != nil || err != nil {
+		t.Fatalf("Expected the partial entry to be ignored, got %v, %v", entry, err)
+	}
+	if err := other.Set("/region/europe", []byte("[3]")); err != nil {
+		t.Fatal(err)
+	}
+	if entry, _ := cache.Get("/region/europe"); entry == nil || string(entry.Data) != "[3]" {
+		t.Fatalf("Expected the entry written after the partial one, got %v", entry)
+	}
+}
+
+func TestDiskCacheExpiry(t *testing.T) {
+	cache, path := newDiskCache(t)
+	defer os.RemoveAll(filepath.Dir(filepath.Dir(path)))
+
+	cache.TTL = time.Nanosecond
+	cache.Set("/all", []byte("[1]"))
+	cache.TTL = time.Hour
+	cache.Set("/alpha/col", []byte("[2]"))
+	time.Sleep(time.Millisecond)
+
+	if entry, err := cache.Get("/all"); entry != nil || err != nil {
+		t.Fatalf("Expected the expired entry to be hidden, got %v, %v", entry, err)
+	}
+	cache.StaleIfError = true
+	if entry, _ := cache.Get("/all"); entry == nil || !entry.Expired(time.Now()) || string(entry.Data) != "[1]" {
+		t.Fatalf("Expected the expired entry with StaleIfError, got %v", entry)
+	}
+}
+
+func TestDiskCacheCompact(t *testing.T) {
+	cache, path := newDiskCache(t)
+	defer os.RemoveAll(filepath.Dir(filepath.Dir(path)))
+
+	if err := cache.Compact(); err != nil {
+		t.Fatalf("Expected nothing to compact, got %v", err)
+	}
+	for i := 0; i < 10; i++ {
+		cache.Set("/all", []byte(fmt.Sprintf("[%d]", i)))
+	}
+	cache.TTL = time.Nanosecond
+	cache.Set("/alpha/col", []byte("[]"))
+	time.Sleep(time.Millisecond)
+	before, _ := os.Stat(path)
+
+	other := countries.NewDiskCache(path)
+	if err := other.Compact(); err != nil {
+		t.Fatal(err)
+	}
+	after, _ := os.Stat(path)
+	if after.Size() >= before.Size()/5 {
+		t.Fatalf("Expected the compacted file to shrink, from %d to %d bytes", before.Size(), after.Size())
+	}
+	if entry, _ := cache.Get("/all"); entry == nil || string(entry.Data) != "[9]" {
+		t.Fatalf("Expected the latest entry after compaction, got %v", entry)
+	}
+	cache.StaleIfError = true
+	if entry, _ := cache.Get("/alpha/col"); entry != nil {
+		t.Fatalf("Expected the expired entry to be compacted, got %v", entry)
+	}
+}
+
+func TestDiskCacheConcurrency(t *testing.T) {
+	_, path := newDiskCache(t)
+	defer os.RemoveAll(filepath.Dir(filepath.Dir(path)))
+
+	wg := sync.WaitGroup{}
+	for p := 0; p < 4; p++ {
+		// Each cache has its own file descriptors, as separate processes do.
+		cache := countries.NewDiskCache(path)
+		for g := 0; g < 5; g++ {
+			wg.Add(1)
+			go func(p, g int) {
+				defer wg.Done()
+				for i := 0; i < 10; i++ {
+					key := fmt.Sprintf("/alpha/%d-%d", p, g)
+					if err := cache.Set(key, []byte(fmt.Sprint(i))); err != nil {
+						t.Error(err)
+					}
+					if entry, err := cache.Get(key); err != nil || entry == nil || string(entry.Data) != fmt.Sprint(i) {
+						t.Errorf("Expected %d for %s, got %v, %v", i, key, entry, err)
+					}
+					if i == 5 && g == 0 {
+						if err := cache.Compact(); err != nil {
+							t.Error(err)
+						}
+					}
+				}
+			}(p, g)
+		}
+	}
+	wg.Wait()
+
+	cache := countries.NewDiskCache(path)
+	for p := 0; p < 4; p++ {
+		for g := 0; g < 5; g++ {
+			if entry, _ := cache.Get(fmt.Sprintf("/alpha/%d-%d", p, g)); entry == nil || string(entry.Data) != "9" {
+				t.Fatalf("Expected the last write of %d-%d, got %v", p, g, entry)
+			}
+		}
+	}
+}
+
+func TestHTTPClientCache(t *testing.T) {
+	calls, status := 0, http.StatusOK
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(status)
+		fmt.Fprintf(w, `[{"name":"Colombia","population":%d}]`, calls)
+	}))
+	defer ts.Close()
+	cache, path := newDiskCache(t)
+	defer os.RemoveAll(filepath.Dir(filepath.Dir(path)))
+
+	client := countries.NewHTTPClient(ts.URL)
+	client.Cache = cache
+	for i := 0; i < 2; i++ {
+		c, err := client.ByCode("col", "name", "population")
+		if err != nil || c[0].Population != 1 || calls != 1 {
+			t.Fatalf("Expected the cached response, got %v, %v after %d calls", c, err, calls)
+		}
+	}
+	if _, err := client.ByCode("col"); err != nil || calls != 2 {
+		t.Fatalf("Expected other fields to be fetched, got %v after %d calls", err, calls)
+	}
+
+	// A new client, as the next run of a command, reads the same cache.
+	cache = countries.NewDiskCache(path)
+	cache.TTL = time.Nanosecond
+	client.Cache = cache
+	if c, err := client.ByCode("col", "name", "population"); err != nil || c[0].Population != 1 {
+		t.Fatalf("Expected the response cached by the previous client, got %v, %v", c, err)
+	}
+	client.ByCode("col", "population")
+	time.Sleep(time.Millisecond)
+	status = http.StatusServiceUnavailable
+	if _, err := client.ByCode("col", "population"); err == nil {
+		t.Fatal("Expected an error for an expired entry without StaleIfError")
+	}
+	cache.StaleIfError = true
+	c, err := client.ByCode("col", "population")
+	if err != nil || c[0].Population != 3 {
+		t.Fatalf("Expected the stale response, got %v, %v", c, err)
+	}
+
+	status = http.StatusNotFound
+	if _, err := client.ByCode("col", "population"); err == nil {
+		t.Fatal("Expected a 404 not to be served from the cache")
+	}
+	status = http.StatusOK
+	c, err = client.ByCode("col", "population")
+	if err != nil || int(c[0].Population) != calls {
+		t.Fatalf("Expected the expired entry to be refreshed, got %v, %v", c, err)
+	}
+}
+
+func TestHTTPClientCacheCoalescing(t *testing.T) {
+	var calls int32
+	release, cancelled := make(chan struct{}), make(chan struct{})
+	ts := blockingServer(&calls, release, cancelled)
+	defer ts.Close()
+	cache, path := newDiskCache(t)
+	defer os.RemoveAll(filepath.Dir(filepath.Dir(path)))
+
+	client := countries.NewHTTPClient(ts.URL)
+	client.Cache = cache
+	wg := sync.WaitGroup{}
+	for i := 0; i < 200; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if c, err := client.All(); err != nil || len(c) != 1 {
+				t.Errorf("Expected the shared response, got %v, %v", c, err)
+			}
+		}()
+	}
+	waitForWaiters(t, client, "/all", 200)
+	close(release)
+	wg.Wait()
+
+	// The shared call writes the cache once, not once per caller.
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if records := strings.Count(string(data), `"key":"/all"`); records != 1 || atomic.LoadInt32(&calls) != 1 {
+		t.Fatalf("Expected a single call and record, got %d calls and %d records", calls, records)
+	}
+}
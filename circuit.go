@@ -0,0 +1,174 @@
+package countries
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// Defaults of the circuit breakers of an HTTPClient.
+const (
+	DefaultFailureThreshold = 5
+	DefaultOpenDuration     = 30 * time.Second
+	DefaultHalfOpenProbes   = 1
+)
+
+// ErrCircuitOpen is returned without calling the API while the circuits of
+// every base URL are open.
+var ErrCircuitOpen = errors.New("Circuit breaker open")
+
+// CircuitState is the state of the circuit breaker of an API endpoint.
+type CircuitState int
+
+const (
+	// CircuitClosed lets calls through.
+	CircuitClosed CircuitState = iota
+	// CircuitOpen rejects calls until the open duration has elapsed.
+	CircuitOpen
+	// CircuitHalfOpen lets probe calls through. The circuit closes once they
+	// all succeed, and opens again on the first failure.
+	CircuitHalfOpen
+)
+
+var circuitStates = []string{"closed", "open", "half-open"}
+
+func (s CircuitState) String() string {
+	if s < 0 || int(s) >= len(circuitStates) {
+		return fmt.Sprintf("CircuitState(%d)", int(s))
+	}
+
+	return circuitStates[s]
+}
+
+// MarshalText writes the state by name, e.g. in JSON health reports.
+func (s CircuitState) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+// EndpointHealth is the health of a base URL of an HTTPClient.
+type EndpointHealth struct {
+	BaseURL string
+	State   CircuitState
+	// Failures is the number of consecutive failed calls.
+	Failures int
+	// LastError is the error of the last failed call, if any.
+	LastError error
+	// Since is the time of the last state change.
+	Since time.Time
+}
+
+// endpoint is a base URL of the API with its circuit breaker.
+type endpoint struct {
+	baseURL   string
+	mu        sync.Mutex
+	state     CircuitState
+	failures  int
+	successes int
+	probes    int
+	lastErr   error
+	since     time.Time
+}
+
+// transition is a change of state of a circuit.
+type transition struct {
+	from, to CircuitState
+}
+
+// allow reports whether a call can be made to the endpoint, moving an open
+// circuit to half-open once the open duration has elapsed. Half-open circuits
+// let maxProbes calls through.
+func (e *endpoint) allow(openDuration time.Duration, maxProbes int) (bool, *transition) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	var t *transition
+	if e.state == CircuitOpen && time.Since(e.since) >= openDuration {
+		t = e.setState(CircuitHalfOpen)
+	}
+	switch e.state {
+	case CircuitClosed:
+		return true, t
+	case CircuitHalfOpen:
+		if e.probes+e.successes >= maxProbes {
+			return false, t
+		}
+		e.probes++
+		return true, t
+	}
+
+	return false, t
+}
+
+// success records a call answered by the endpoint. A half-open circuit closes
+// once maxProbes probes have succeeded.
+func (e *endpoint) success(maxProbes int) *transition {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.failures = 0
+	if e.state != CircuitHalfOpen || e.probes == 0 {
+		return nil
+	}
+	e.probes--
+	e.successes++
+	if e.successes < maxProbes {
+		return nil
+	}
+
+	return e.setState(CircuitClosed)
+}
+
+// failure records a failed call, opening the circuit after threshold
+// consecutive failures, or right away for a failed probe.
+func (e *endpoint) failure(err error, threshold int) *transition {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.failures++
+	e.lastErr = err
+	if e.state == CircuitHalfOpen || e.state == CircuitClosed && e.failures >= threshold {
+		return e.setState(CircuitOpen)
+	}
+
+	return nil
+}
+
+// release gives back a probe whose call was cancelled by the caller.
+func (e *endpoint) release() {
+	e.mu.Lock()
+	if e.state == CircuitHalfOpen && e.probes > 0 {
+		e.probes--
+	}
+	e.mu.Unlock()
+}
+
+func (e *endpoint) setState(state CircuitState) *transition {
+	t := &transition{from: e.state, to: state}
+	e.state = state
+	e.since = time.Now()
+	e.probes = 0
+	e.successes = 0
+
+	return t
+}
+
+func (e *endpoint) health() EndpointHealth {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	return EndpointHealth{BaseURL: e.baseURL, State: e.state, Failures: e.failures, LastError: e.lastErr, Since: e.since}
+}
+
+// unavailable reports whether the error shows the API is unreachable, failing
+// or behind an open circuit, rather than rejecting the call. The context errors
+// of a caller giving up say nothing of the API.
+func unavailable(err error) bool {
+	if err == context.Canceled || err == context.DeadlineExceeded {
+		return false
+	}
+	e, ok := err.(*StatusError)
+	return !ok || e.StatusCode >= http.StatusInternalServerError
+}
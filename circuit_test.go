@@ -0,0 +1,236 @@
+package countries_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/georgesafta/countries"
+)
+
+// statusServer answers with the current status, and a country on 200.
+func statusServer(status *int32, calls *int32) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(calls, 1)
+		w.WriteHeader(int(atomic.LoadInt32(status)))
+		w.Write([]byte(`[{"name":"Colombia"}]`))
+	}))
+}
+
+func TestFailover(t *testing.T) {
+	var primaryStatus, primaryCalls, fallbackStatus, fallbackCalls int32 = http.StatusServiceUnavailable, 0, http.StatusOK, 0
+	primary := statusServer(&primaryStatus, &primaryCalls)
+	defer primary.Close()
+	fallback := statusServer(&fallbackStatus, &fallbackCalls)
+	defer fallback.Close()
+
+	client := countries.NewHTTPClient(primary.URL, fallback.URL)
+	client.FailureThreshold = 2
+	client.OpenDuration = 50 * time.Millisecond
+	for i := 0; i < 4; i++ {
+		if c, err := client.ByName("colombia"); err != nil || len(c) != 1 {
+			t.Fatalf("Expected the fallback response, got %v, %v", c, err)
+		}
+	}
+	if primaryCalls != 2 || fallbackCalls != 4 {
+		t.Fatalf("Expected the primary circuit to open after 2 failures, got %d primary and %d fallback calls", primaryCalls, fallbackCalls)
+	}
+	health := client.Health()
+	if health[0].BaseURL != primary.URL || health[0].State != countries.CircuitOpen || health[0].Failures != 2 || health[1].State != countries.CircuitClosed {
+		t.Fatalf("Unexpected health %+v", health)
+	}
+	if err, ok := health[0].LastError.(*countries.StatusError); !ok || err.StatusCode != http.StatusServiceUnavailable {
+		t.Fatalf("Expected the last error of the primary, got %v", health[0].LastError)
+	}
+
+	// Once the open duration has elapsed, a probe closes the circuit of the recovered primary.
+	time.Sleep(60 * time.Millisecond)
+	atomic.StoreInt32(&primaryStatus, http.StatusOK)
+	if _, err := client.ByName("colombia"); err != nil || primaryCalls != 3 || fallbackCalls != 4 {
+		t.Fatalf("Expected a probe to the primary, got %v after %d primary calls", err, primaryCalls)
+	}
+	if health := client.Health(); health[0].State != countries.CircuitClosed || health[0].Failures != 0 {
+		t.Fatalf("Expected the primary circuit to close, got %+v", health[0])
+	}
+
+	// A rejected call is answered by the API, so it neither fails over nor opens the circuit.
+	atomic.StoreInt32(&primaryStatus, http.StatusNotFound)
+	for i := 0; i < 3; i++ {
+		if _, err := client.ByName("atlantis"); err == nil {
+			t.Fatal("Expected a not found error")
+		}
+	}
+	if fallbackCalls != 4 || client.Health()[0].State != countries.CircuitClosed {
+		t.Fatalf("Expected no failover on 404, got %d fallback calls", fallbackCalls)
+	}
+}
+
+func TestFailoverUnreachable(t *testing.T) {
+	var status, calls int32 = http.StatusInternalServerError, 0
+	down := httptest.NewServer(http.NotFoundHandler())
+	down.Close()
+	failing := statusServer(&status, &calls)
+	defer failing.Close()
+
+	client := countries.NewHTTPClient(down.URL, failing.URL)
+	client.FailureThreshold = 1
+	if _, err := client.All(); err == nil {
+		t.Fatal("Expected an error when every base URL fails")
+	}
+	for _, h := range client.Health() {
+		if h.State != countries.CircuitOpen || h.LastError == nil {
+			t.Fatalf("Expected every circuit to be open, got %+v", h)
+		}
+	}
+	if _, err := client.All(); err != countries.ErrCircuitOpen || calls != 1 {
+		t.Fatalf("Expected open circuits to skip the calls, got %v after %d calls", err, calls)
+	}
+}
+
+func TestFailoverDefaults(t *testing.T) {
+	if _, err := (&countries.HTTPClient{}).All(); err == nil || err == countries.ErrCircuitOpen {
+		t.Fatalf("Expected an error for a client not created with NewHTTPClient, got %v", err)
+	}
+
+	var status, calls int32 = http.StatusInternalServerError, 0
+	ts := statusServer(&status, &calls)
+	defer ts.Close()
+	client := countries.NewHTTPClient(ts.URL)
+	client.FailureThreshold = 0
+	client.All()
+	if state := client.Health()[0].State; state != countries.CircuitOpen {
+		t.Fatalf("Expected a threshold below 1 to open the circuit on the first failure, got %s", state)
+	}
+}
+
+func TestCircuitBreaker(t *testing.T) {
+	var status, calls int32 = http.StatusBadGateway, 0
+	ts := statusServer(&status, &calls)
+	defer ts.Close()
+
+	var transitions, baseURLs []string
+	client := countries.NewHTTPClient(ts.URL)
+	client.FailureThreshold = 3
+	client.OpenDuration = 30 * time.Millisecond
+	client.HalfOpenProbes = 2
+	client.OnStateChange = func(baseURL string, from, to countries.CircuitState) {
+		baseURLs = append(baseURLs, baseURL)
+		transitions = append(transitions, from.String()+" > "+to.String())
+	}
+	for i := 0; i < 3; i++ {
+		if _, err := client.All(); err == nil || err == countries.ErrCircuitOpen {
+			t.Fatalf("Expected the API error, got %v", err)
+		}
+	}
+	if _, err := client.All(); err != countries.ErrCircuitOpen || calls != 3 {
+		t.Fatalf("Expected ErrCircuitOpen without calling the API, got %v after %d calls", err, calls)
+	}
+
+	// A failed probe opens the circuit again.
+	time.Sleep(40 * time.Millisecond)
+	if _, err := client.All(); err == nil || err == countries.ErrCircuitOpen || calls != 4 {
+		t.Fatalf("Expected a failed probe, got %v after %d calls", err, calls)
+	}
+	if _, err := client.All(); err != countries.ErrCircuitOpen {
+		t.Fatalf("Expected the circuit to open again, got %v", err)
+	}
+
+	// The circuit closes once every probe has succeeded.
+	time.Sleep(40 * time.Millisecond)
+	atomic.StoreInt32(&status, http.StatusOK)
+	for i := 0; i < 2; i++ {
+		if _, err := client.All(); err != nil {
+			t.Fatal(err)
+		}
+		if state := client.Health()[0].State; state != []countries.CircuitState{countries.CircuitHalfOpen, countries.CircuitClosed}[i] {
+			t.Fatalf("Unexpected state %s after %d probes", state, i+1)
+		}
+	}
+
+	for _, baseURL := range baseURLs {
+		if baseURL != ts.URL {
+			t.Fatalf("Unexpected base URL %s", baseURL)
+		}
+	}
+	expected := []string{"closed > open", "open > half-open", "half-open > open", "open > half-open", "half-open > closed"}
+	if len(transitions) != len(expected) {
+		t.Fatalf("Expected transitions %v, got %v", expected, transitions)
+	}
+	for i := range expected {
+		if transitions[i] != expected[i] {
+			t.Fatalf("Expected transitions %v, got %v", expected, transitions)
+		}
+	}
+}
+
+func TestCircuitBreakerFallback(t *testing.T) {
+	var status, calls int32 = http.StatusOK, 0
+	ts := statusServer(&status, &calls)
+	defer ts.Close()
+	cache, path := newDiskCache(t)
+	defer os.RemoveAll(filepath.Dir(filepath.Dir(path)))
+
+	client := countries.NewHTTPClient(ts.URL)
+	client.FailureThreshold = 1
+	client.Cache = cache
+	cache.TTL = time.Nanosecond
+	cache.StaleIfError = true
+	client.ByName("colombia")
+	atomic.StoreInt32(&status, http.StatusServiceUnavailable)
+	client.All()
+	if state := client.Health()[0].State; state != countries.CircuitOpen {
+		t.Fatalf("Expected the circuit to open, got %s", state)
+	}
+
+	// Open circuits are answered from the cache, then from the fallback dataset.
+	if c, err := client.ByName("colombia"); err != nil || len(c) != 1 || calls != 2 {
+		t.Fatalf("Expected the cached response, got %v, %v after %d calls", c, err, calls)
+	}
+	if _, err := client.ByName("peru"); err != countries.ErrCircuitOpen {
+		t.Fatalf("Expected ErrCircuitOpen without a cached response, got %v", err)
+	}
+	client.Fallback = countries.NewServer(loadDataset(t))
+	c, err := client.ByName("peru", "name", "capital")
+	if err != nil || len(c) != 1 || c[0].Capital != "Lima" || calls != 2 {
+		t.Fatalf("Expected the fallback response, got %v, %v after %d calls", c, err, calls)
+	}
+	if _, err := client.ByName("atlantis"); err == nil || err.(*countries.StatusError).StatusCode != http.StatusNotFound {
+		t.Fatalf("Expected the fallback not found error, got %v", err)
+	}
+}
+
+func TestCircuitBreakerFallbackCancelled(t *testing.T) {
+	var calls int32
+	release, cancelled := make(chan struct{}), make(chan struct{})
+	ts := blockingServer(&calls, release, cancelled)
+	defer ts.Close()
+	defer close(release)
+
+	// A caller giving up gets its own error, not the fallback response.
+	client := countries.NewHTTPClient(ts.URL)
+	client.Fallback = countries.NewServer(loadDataset(t))
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if c, err := client.WithContext(ctx).ByName("peru"); err != context.DeadlineExceeded {
+		t.Fatalf("Expected the deadline to be honored, got %v, %v", c, err)
+	}
+	if state := client.Health()[0].State; state != countries.CircuitClosed {
+		t.Fatalf("Expected the cancelled call not to count as a failure, got %s", state)
+	}
+}
+
+func TestCircuitStateJSON(t *testing.T) {
+	data, err := json.Marshal(countries.EndpointHealth{State: countries.CircuitHalfOpen})
+	if err != nil || string(data) != `{"BaseURL":"","State":"half-open","Failures":0,"LastError":null,"Since":"0001-01-01T00:00:00Z"}` {
+		t.Fatalf("Unexpected health JSON %s, %v", data, err)
+	}
+	if s := countries.CircuitState(7).String(); s != "CircuitState(7)" {
+		t.Fatalf("Unexpected unknown state %s", s)
+	}
+}
@@ -0,0 +1,90 @@
+// Command countries-server serves the v2 countries API from a local dataset.
+//
+// Usage:
+//
+//	countries-server --data countries.json [--addr :8080] [--prefix /rest/v2] [--graphql /graphql]
+//
+// The dataset is a JSON array of countries, as returned by /all, or a CSV, TSV
+// or NDJSON file written by the countries encoders, chosen by file extension.
+// GraphQL queries over the same dataset are served at the --graphql path,
+// unless it is empty.
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/georgesafta/countries"
+)
+
+func main() {
+	addr := flag.String("addr", ":8080", "address to listen on")
+	data := flag.String("data", "", "dataset file: .json, .csv, .tsv or .ndjson")
+	prefix := flag.String("prefix", "/rest/v2", "path prefix of the API")
+	graphql := flag.String("graphql", "/graphql", "path of the GraphQL endpoint, empty to disable")
+	flag.Parse()
+
+	if *data == "" {
+		fmt.Fprintln(os.Stderr, "countries-server: --data is required")
+		flag.Usage()
+		os.Exit(2)
+	}
+	dataset, err := load(*data)
+	if err != nil {
+		log.Fatalf("Error loading %s: %v", *data, err)
+	}
+
+	mux := http.NewServeMux()
+	server := countries.NewServer(dataset)
+	p := "/" + strings.Trim(*prefix, "/")
+	if p == "/" {
+		mux.Handle("/", server)
+	} else {
+		mux.Handle(p+"/", http.StripPrefix(p, server))
+	}
+	if *graphql != "" {
+		mux.Handle("/"+strings.Trim(*graphql, "/"), countries.NewGraphQLHandler(dataset))
+	}
+
+	log.Printf("Serving %d countries on %s%s", len(dataset), *addr, strings.TrimSuffix(p, "/"))
+	log.Fatal(http.ListenAndServe(*addr, mux))
+}
+
+// load reads the dataset, in the format given by the file extension.
+func load(path string) ([]countries.Country, error) {
+	ext := strings.ToLower(strings.TrimPrefix(filepath.Ext(path), "."))
+	if ext == "json" {
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		var c []countries.Country
+		if err := json.Unmarshal(data, &c); err != nil {
+			return nil, err
+		}
+		return c, nil
+	}
+
+	format, err := countries.ParseFormat(ext)
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	d, err := countries.NewDecoder(f, format)
+	if err != nil {
+		return nil, err
+	}
+
+	return d.Decode()
+}
@@ -0,0 +1,269 @@
+// Command countries queries the countries API from the command line.
+//
+// Usage:
+//
+//	countries [flags] <command> [arguments]
+//
+// The matching countries are printed as indented JSON, or in the format given
+// by --format: csv, tsv, ndjson, yaml or table. The exit code is 0 on success,
+// 1 on failure, 2 on invalid usage and 3 when no country matches. With --cache,
+// responses are kept in a file shared by every run.
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/georgesafta/countries"
+)
+
+// Exit codes of the command.
+const (
+	exitOK       = 0
+	exitFailure  = 1
+	exitUsage    = 2
+	exitNotFound = 3
+)
+
+// errNotFound is returned when no country matches the query.
+var errNotFound = errors.New("No country found")
+
+type command struct {
+	usage string
+	args  int
+	run   func(c *countries.HTTPClient, args []string, fields []string) ([]countries.Country, error)
+}
+
+var commands = map[string]command{
+	"name": {"<name>", 1, func(c *countries.HTTPClient, args, fields []string) ([]countries.Country, error) {
+		return c.ByName(args[0], fields...)
+	}},
+	"fullname": {"<name>", 1, func(c *countries.HTTPClient, args, fields []string) ([]countries.Country, error) {
+		return c.ByFullName(args[0], fields...)
+	}},
+	"code": {"<code>", 1, func(c *countries.HTTPClient, args, fields []string) ([]countries.Country, error) {
+		return c.ByCode(args[0], fields...)
+	}},
+	"codes": {"<code>...", -1, func(c *countries.HTTPClient, args, fields []string) ([]countries.Country, error) {
+		return c.ByCodes(splitList(args), fields...)
+	}},
+	"capital": {"<name>", 1, func(c *countries.HTTPClient, args, fields []string) ([]countries.Country, error) {
+		return c.ByCapital(args[0], fields...)
+	}},
+	"currency": {"<code>", 1, func(c *countries.HTTPClient, args, fields []string) ([]countries.Country, error) {
+		return c.ByCurrency(args[0], fields...)
+	}},
+	"lang": {"<code>", 1, func(c *countries.HTTPClient, args, fields []string) ([]countries.Country, error) {
+		return c.ByLanguage(args[0], fields...)
+	}},
+	"callingcode": {"<code>", 1, func(c *countries.HTTPClient, args, fields []string) ([]countries.Country, error) {
+		return c.ByCallingCode(strings.TrimPrefix(args[0], "+"), fields...)
+	}},
+	"region": {"<region>", 1, func(c *countries.HTTPClient, args, fields []string) ([]countries.Country, error) {
+		region, err := countries.ParseRegion(args[0])
+		if err != nil {
+			return nil, usageError{err}
+		}
+		return c.ByRegion(strings.ToLower(string(region)), fields...)
+	}},
+	"bloc": {"<acronym>", 1, func(c *countries.HTTPClient, args, fields []string) ([]countries.Country, error) {
+		return c.ByRegionalBloc(strings.ToLower(args[0]), fields...)
+	}},
+	"all": {"", 0, func(c *countries.HTTPClient, args, fields []string) ([]countries.Country, error) {
+		return c.All(fields...)
+	}},
+}
+
+var commandOrder = []string{"name", "fullname", "code", "codes", "capital", "currency", "lang", "callingcode", "region", "bloc", "all"}
+
+// usageError is an invalid command line.
+type usageError struct {
+	error
+}
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr))
+}
+
+// run executes the command line and returns the exit code.
+// Flags are accepted both before and after the command.
+func run(args []string, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("countries", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	fields := fs.String("fields", "", "comma separated list of fields to return, e.g. name,currencies.code")
+	format := fs.String("format", "json", "output format: json, csv, tsv, ndjson, yaml or table")
+	baseURL := fs.String("base-url", countries.BaseURL, "comma separated base urls of the countries API, tried in order")
+	timeout := fs.Duration("timeout", 30*time.Second, "timeout of each API call")
+	verbose := fs.Bool("verbose", false, "log the API calls")
+	cache := fs.String("cache", "", "file caching the API responses across runs")
+	cacheTTL := fs.Duration("cache-ttl", countries.DefaultCacheTTL, "time cached responses stay fresh")
+	staleIfError := fs.Bool("stale-if-error", false, "serve expired cached responses when the API is down")
+	fs.Usage = func() { usage(fs, stderr) }
+
+	if err := fs.Parse(args); err != nil {
+		return exitUsage
+	}
+	if fs.NArg() == 0 {
+		fs.Usage()
+		return exitUsage
+	}
+	name := fs.Arg(0)
+	cmd, ok := commands[name]
+	if !ok {
+		fmt.Fprintf(stderr, "countries: unknown command %q\n", name)
+		fs.Usage()
+		return exitUsage
+	}
+	var cmdArgs []string
+	rest := fs.Args()[1:]
+	for len(rest) > 0 {
+		if err := fs.Parse(rest); err != nil {
+			return exitUsage
+		}
+		if fs.NArg() == 0 {
+			break
+		}
+		cmdArgs = append(cmdArgs, fs.Arg(0))
+		rest = fs.Args()[1:]
+	}
+	if (cmd.args >= 0 && len(cmdArgs) != cmd.args) || (cmd.args < 0 && len(cmdArgs) == 0) {
+		fmt.Fprintf(stderr, "usage: countries %s %s\n", name, cmd.usage)
+		return exitUsage
+	}
+
+	columns := splitList([]string{*fields})
+	write, err := printer(*format, columns)
+	if err != nil {
+		fmt.Fprintf(stderr, "countries: %v\n", err)
+		return exitUsage
+	}
+
+	baseURLs := splitList([]string{*baseURL})
+	for i, u := range baseURLs {
+		baseURLs[i] = strings.TrimSuffix(u, "/")
+	}
+	if len(baseURLs) == 0 {
+		fmt.Fprintln(stderr, "countries: --base-url is empty")
+		return exitUsage
+	}
+
+	if !*verbose {
+		log.SetOutput(ioutil.Discard)
+		defer log.SetOutput(os.Stderr)
+	}
+	client := countries.NewHTTPClient(baseURLs[0], baseURLs[1:]...)
+	client.Client.Timeout = *timeout
+	if *cache != "" {
+		diskCache := countries.NewDiskCache(*cache)
+		diskCache.TTL = *cacheTTL
+		diskCache.StaleIfError = *staleIfError
+		client.Cache = diskCache
+	}
+
+	result, err := cmd.run(client, cmdArgs, apiFields(columns))
+	if err == nil && len(result) == 0 {
+		err = errNotFound
+	}
+	if err != nil {
+		fmt.Fprintf(stderr, "countries: %v\n", err)
+		return exitCode(err)
+	}
+
+	if err := write(stdout, result); err != nil {
+		fmt.Fprintf(stderr, "countries: %v\n", err)
+		return exitFailure
+	}
+
+	return exitOK
+}
+
+func exitCode(err error) int {
+	if _, ok := err.(usageError); ok {
+		return exitUsage
+	}
+	if statusErr, ok := err.(*countries.StatusError); ok && statusErr.StatusCode == http.StatusNotFound {
+		return exitNotFound
+	}
+	if err == errNotFound {
+		return exitNotFound
+	}
+
+	return exitFailure
+}
+
+// printer returns the function writing the countries in the format.
+func printer(format string, columns []string) (func(io.Writer, []countries.Country) error, error) {
+	if format == "json" {
+		return func(w io.Writer, result []countries.Country) error {
+			enc := json.NewEncoder(w)
+			enc.SetIndent("", "  ")
+			return enc.Encode(result)
+		}, nil
+	}
+
+	f, err := countries.ParseFormat(format)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := countries.NewEncoder(ioutil.Discard, f, columns...); err != nil {
+		return nil, err
+	}
+
+	return func(w io.Writer, result []countries.Country) error {
+		enc, err := countries.NewEncoder(w, f, columns...)
+		if err != nil {
+			return err
+		}
+		return enc.Encode(result)
+	}, nil
+}
+
+// apiFields returns the fields filter of the columns, e.g. currencies for currencies.code.
+func apiFields(columns []string) []string {
+	var fields []string
+	seen := map[string]bool{}
+	for _, c := range columns {
+		if i := strings.Index(c, "."); i >= 0 {
+			c = c[:i]
+		}
+		if !seen[c] {
+			seen[c] = true
+			fields = append(fields, c)
+		}
+	}
+
+	return fields
+}
+
+// splitList splits comma separated arguments, dropping empty values.
+func splitList(args []string) []string {
+	var values []string
+	for _, arg := range args {
+		for _, v := range strings.Split(arg, ",") {
+			if v = strings.TrimSpace(v); v != "" {
+				values = append(values, v)
+			}
+		}
+	}
+
+	return values
+}
+
+func usage(fs *flag.FlagSet, w io.Writer) {
+	fmt.Fprintln(w, "usage: countries [flags] <command> [arguments]")
+	fmt.Fprintln(w, "\ncommands:")
+	for _, name := range commandOrder {
+		fmt.Fprintln(w, strings.TrimRight(fmt.Sprintf("  %-12s %s", name, commands[name].usage), " "))
+	}
+	fmt.Fprintln(w, "\nflags:")
+	fs.PrintDefaults()
+}
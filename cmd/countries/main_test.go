@@ -0,0 +1,170 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/georgesafta/countries"
+)
+
+const fullDataMockPath = "../../mock/full_data.json"
+
+func TestRun(t *testing.T) {
+	var requested string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requested = r.URL.RequestURI()
+		data, _ := ioutil.ReadFile(fullDataMockPath)
+		w.Write(data)
+	}))
+	defer ts.Close()
+
+	for args, expectedURL := range map[string]string{
+		"name colombia":                 "/name/colombia",
+		"fullname colombia":             "/name/colombia?fullText=true",
+		"code co --fields name,capital": "/alpha/co?fields=name;capital",
+		"codes co,pe br":                "/alpha?codes=co;pe;br",
+		"capital bogota":                "/capital/bogota",
+		"currency cop":                  "/currency/cop",
+		"lang es":                       "/lang/es",
+		"callingcode +57":               "/callingcode/57",
+		"--fields=name region Americas": "/region/americas?fields=name",
+		"bloc PA":                       "/regionalbloc/pa",
+		"all":                           "/all",
+	} {
+		stdout, stderr := bytes.Buffer{}, bytes.Buffer{}
+		code := run(append([]string{"--base-url", ts.URL}, strings.Fields(args)...), &stdout, &stderr)
+		if code != exitOK {
+			t.Fatalf("Expected success for %q, got %d: %s", args, code, stderr.String())
+		}
+		if requested != expectedURL {
+			t.Fatalf("Expected call to %s for %q, got %s", expectedURL, args, requested)
+		}
+
+		var result []countries.Country
+		if err := json.Unmarshal(stdout.Bytes(), &result); err != nil || len(result) != 1 || result[0].Alpha3Code != "COL" {
+			t.Fatalf("Unexpected output for %q: %s", args, stdout.String())
+		}
+	}
+}
+
+func TestRunEscaping(t *testing.T) {
+	var requested string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requested = r.URL.RequestURI()
+		w.Write([]byte(`[{"name":"Colombia"}]`))
+	}))
+	defer ts.Close()
+
+	for expectedURL, args := range map[string][]string{
+		"/name/united%20states%3Ffields=x%23": {"name", "united states?fields=x#"},
+		"/alpha/co%2F..?fields=name":          {"--fields", "name", "code", "co/.."},
+		"/alpha?codes=co%26x%3Dy;pe":          {"codes", "co&x=y,pe"},
+	} {
+		stdout, stderr := bytes.Buffer{}, bytes.Buffer{}
+		if code := run(append([]string{"--base-url", ts.URL}, args...), &stdout, &stderr); code != exitOK {
+			t.Fatalf("Expected success for %q, got %d: %s", args, code, stderr.String())
+		}
+		if requested != expectedURL {
+			t.Fatalf("Expected call to %s for %q, got %s", expectedURL, args, requested)
+		}
+	}
+}
+
+func TestRunFormat(t *testing.T) {
+	var requested string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requested = r.URL.RequestURI()
+		data, _ := ioutil.ReadFile(fullDataMockPath)
+		w.Write(data)
+	}))
+	defer ts.Close()
+
+	stdout, stderr := bytes.Buffer{}, bytes.Buffer{}
+	code := run([]string{"--base-url", ts.URL, "--format", "csv", "--fields", "name,currencies.code,currencies.name", "code", "co"}, &stdout, &stderr)
+	if code != exitOK {
+		t.Fatalf("Expected success, got %d: %s", code, stderr.String())
+	}
+	if requested != "/alpha/co?fields=name;currencies" {
+		t.Fatalf("Expected nested columns to request their field, got %s", requested)
+	}
+	if stdout.String() != "name,currencies.code,currencies.name\nColombia,COP,Colombian peso\n" {
+		t.Fatalf("Unexpected CSV output %q", stdout.String())
+	}
+
+	for _, args := range [][]string{{"--format", "xml", "all"}, {"--format", "yaml", "--fields", "currencies.code", "all"}} {
+		if code := run(append([]string{"--base-url", ts.URL}, args...), &stdout, &stderr); code != exitUsage {
+			t.Fatalf("Expected usage error for %v, got %d", args, code)
+		}
+	}
+}
+
+func TestRunExitCodes(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/name/missing":
+			w.WriteHeader(http.StatusNotFound)
+		case "/name/empty":
+			w.Write([]byte("[]"))
+		case "/name/slow":
+			time.Sleep(200 * time.Millisecond)
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	defer ts.Close()
+
+	for args, expected := range map[string]int{
+		"":                         exitUsage,
+		"unknown":                  exitUsage,
+		"name":                     exitUsage,
+		"name a b":                 exitUsage,
+		"codes":                    exitUsage,
+		"all extra":                exitUsage,
+		"region Atlantis":          exitUsage,
+		"--nope all":               exitUsage,
+		"name missing":             exitNotFound,
+		"name empty":               exitNotFound,
+		"name broken":              exitFailure,
+		"--timeout 10ms name slow": exitFailure,
+	} {
+		stdout, stderr := bytes.Buffer{}, bytes.Buffer{}
+		code := run(append([]string{"--base-url", ts.URL}, strings.Fields(args)...), &stdout, &stderr)
+		if code != expected {
+			t.Fatalf("Expected exit code %d for %q, got %d: %s", expected, args, code, stderr.String())
+		}
+		if stdout.Len() != 0 {
+			t.Fatalf("Expected no output for %q, got %s", args, stdout.String())
+		}
+	}
+}
+
+func TestRunCache(t *testing.T) {
+	calls := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		data, _ := ioutil.ReadFile(fullDataMockPath)
+		w.Write(data)
+	}))
+	defer ts.Close()
+	dir, err := ioutil.TempDir("", "cache")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	args := []string{"--base-url", ts.URL, "--cache", filepath.Join(dir, "countries.db"), "name", "colombia"}
+	for i := 0; i < 2; i++ {
+		stdout, stderr := bytes.Buffer{}, bytes.Buffer{}
+		if code := run(args, &stdout, &stderr); code != exitOK || calls != 1 {
+			t.Fatalf("Expected the second run to be cached, got %d after %d calls: %s", code, calls, stderr.String())
+		}
+	}
+}
@@ -0,0 +1,69 @@
+package countries
+
+import (
+	"context"
+	"sync"
+)
+
+// flightGroup coalesces identical concurrent calls into a single one.
+type flightGroup struct {
+	mu      sync.Mutex
+	flights map[string]*flight
+}
+
+// flight is a call in progress, shared by its waiters.
+type flight struct {
+	done    chan struct{}
+	cancel  context.CancelFunc
+	waiters int
+	data    []byte
+	err     error
+}
+
+func newFlightGroup() *flightGroup {
+	return &flightGroup{flights: map[string]*flight{}}
+}
+
+// do returns the result of call for the key, joining the call in progress for
+// the same key if any. A caller whose context is done returns right away, and
+// the call is cancelled once every caller has returned.
+func (g *flightGroup) do(ctx context.Context, key string, call func(context.Context) ([]byte, error)) ([]byte, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	g.mu.Lock()
+	f, ok := g.flights[key]
+	if !ok {
+		callCtx, cancel := context.WithCancel(context.Background())
+		f = &flight{done: make(chan struct{}), cancel: cancel}
+		g.flights[key] = f
+		go func() {
+			f.data, f.err = call(callCtx)
+			g.mu.Lock()
+			if g.flights[key] == f {
+				delete(g.flights, key)
+			}
+			g.mu.Unlock()
+			cancel()
+			close(f.done)
+		}()
+	}
+	f.waiters++
+	g.mu.Unlock()
+
+	select {
+	case <-f.done:
+		return f.data, f.err
+	case <-ctx.Done():
+		g.mu.Lock()
+		f.waiters--
+		if f.waiters == 0 && g.flights[key] == f {
+			// Later callers start a new call rather than joining a cancelled one.
+			delete(g.flights, key)
+			f.cancel()
+		}
+		g.mu.Unlock()
+		return nil, ctx.Err()
+	}
+}
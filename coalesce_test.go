@@ -0,0 +1,136 @@
+package countries_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/georgesafta/countries"
+)
+
+// blockingServer answers every call once release is closed.
+func blockingServer(calls *int32, release chan struct{}, cancelled chan struct{}) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(calls, 1)
+		select {
+		case <-release:
+			w.Write([]byte(`[{"name":"Colombia"}]`))
+		case <-r.Context().Done():
+			close(cancelled)
+		}
+	}))
+}
+
+// waitForWaiters waits until n callers share the call in progress to the endpoint.
+func waitForWaiters(t *testing.T, client *countries.HTTPClient, endpoint string, n int) {
+	deadline := time.Now().Add(5 * time.Second)
+	for client.Waiters(endpoint) != n {
+		if time.Now().After(deadline) {
+			t.Fatalf("Expected %d callers to share the call to %s, got %d", n, endpoint, client.Waiters(endpoint))
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestCoalescing(t *testing.T) {
+	var calls int32
+	release, cancelled := make(chan struct{}), make(chan struct{})
+	ts := blockingServer(&calls, release, cancelled)
+	defer ts.Close()
+	client := countries.NewHTTPClient(ts.URL)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancelledErr := make(chan error)
+	go func() {
+		_, err := client.WithContext(ctx).ByRegion("europe", "name")
+		cancelledErr <- err
+	}()
+
+	wg := sync.WaitGroup{}
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			c, err := client.ByRegion("europe", "name")
+			if err != nil || len(c) != 1 || c[0].Name != "Colombia" {
+				t.Errorf("Expected the shared response, got %v, %v", c, err)
+			}
+		}()
+	}
+	waitForWaiters(t, client, "/region/europe?fields=name", 51)
+
+	// A caller giving up does not cancel the call shared with the others.
+	cancel()
+	if err := <-cancelledErr; err != context.Canceled {
+		t.Fatalf("Expected the cancelled caller to return, got %v", err)
+	}
+	close(release)
+	wg.Wait()
+	if atomic.LoadInt32(&calls) != 1 {
+		t.Fatalf("Expected a single API call, got %d", calls)
+	}
+
+	if _, err := client.ByRegion("europe", "name", "capital"); err != nil || atomic.LoadInt32(&calls) != 2 {
+		t.Fatalf("Expected other fields not to be coalesced, got %v after %d calls", err, calls)
+	}
+}
+
+func TestCoalescingAbandoned(t *testing.T) {
+	var calls int32
+	release, cancelled := make(chan struct{}), make(chan struct{})
+	ts := blockingServer(&calls, release, cancelled)
+	defer ts.Close()
+	defer close(release)
+	client := countries.NewHTTPClient(ts.URL)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	wg := sync.WaitGroup{}
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := client.WithContext(ctx).All(); err != context.DeadlineExceeded {
+				t.Errorf("Expected the deadline to be honored, got %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	select {
+	case <-cancelled:
+	case <-time.After(time.Second):
+		t.Fatal("Expected the API call to be cancelled once every caller gave up")
+	}
+	if atomic.LoadInt32(&calls) != 1 {
+		t.Fatalf("Expected a single API call, got %d", calls)
+	}
+}
+
+func TestCoalescingCancelledStale(t *testing.T) {
+	var calls int32
+	release, cancelled := make(chan struct{}), make(chan struct{})
+	ts := blockingServer(&calls, release, cancelled)
+	defer ts.Close()
+	defer close(release)
+	cache, path := newDiskCache(t)
+	defer os.RemoveAll(filepath.Dir(filepath.Dir(path)))
+	cache.TTL = time.Nanosecond
+	cache.StaleIfError = true
+	cache.Set("/all", []byte(`[{"name":"Peru"}]`))
+
+	// A caller giving up gets its own error, not the expired entry.
+	client := countries.NewHTTPClient(ts.URL)
+	client.Cache = cache
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if c, err := client.WithContext(ctx).All(); err != context.DeadlineExceeded {
+		t.Fatalf("Expected the deadline to be honored, got %v, %v", c, err)
+	}
+}
@@ -1,12 +1,17 @@
 package countries
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"log"
 	"net/http"
+	"net/url"
 	"strings"
+	"time"
 )
 
 // BaseURL is the base url of the countries API, use it when initialising the client.
@@ -19,24 +24,94 @@ const (
 )
 
 // HTTPClient is a wrapper over http.Client.
+// When Cache is set, responses are read from and stored in it.
+//
+// Identical concurrent calls, to the same endpoint with the same fields, share
+// a single API call. Clients returned by WithContext share it too.
+//
+// Calls go to the first base URL whose circuit is not open, and fail over to
+// the next one on network errors and 5xx status codes. A circuit opens after
+// FailureThreshold consecutive failures, and lets HalfOpenProbes calls through
+// once OpenDuration has elapsed. OnStateChange is called on every change of
+// state, and Health reports the state of every base URL.
+//
+// While every circuit is open, calls return ErrCircuitOpen right away. When the
+// API is unavailable, calls are answered from the expired entries of the Cache
+// if it keeps them, then from the Fallback handler if set, e.g. a Server over
+// an offline dataset.
+//
+// Clients must be created with NewHTTPClient, calls of other clients fail.
 type HTTPClient struct {
-	Client  *http.Client
-	baseURL string
+	Client   *http.Client
+	Cache    Cache
+	Fallback http.Handler
+	// FailureThreshold is the number of consecutive failures opening a
+	// circuit. Values below 1 open it on the first failure.
+	FailureThreshold int
+	OpenDuration     time.Duration
+	// HalfOpenProbes is the number of calls let through a half-open circuit.
+	// Values below 1 let one call through.
+	HalfOpenProbes int
+	OnStateChange  func(baseURL string, from, to CircuitState)
+	endpoints      []*endpoint
+	ctx            context.Context
+	flights        *flightGroup
 }
 
-// NewHTTPClient returns a new HTTPClient.
-func NewHTTPClient(baseURL string) *HTTPClient {
-	return &HTTPClient{
-		Client:  &http.Client{},
-		baseURL: baseURL,
+// StatusError is returned when the API responds with an unexpected status code.
+type StatusError struct {
+	StatusCode int
+	Status     string
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("Unexpected API status code %s", e.Status)
+}
+
+// errUninitialized is returned by the calls of a client not created with NewHTTPClient.
+var errUninitialized = errors.New("HTTPClient not created with NewHTTPClient")
+
+// NewHTTPClient returns a new HTTPClient calling baseURL, then the fallback
+// base URLs in order when it is unavailable.
+func NewHTTPClient(baseURL string, fallbacks ...string) *HTTPClient {
+	c := &HTTPClient{
+		Client:           &http.Client{},
+		FailureThreshold: DefaultFailureThreshold,
+		OpenDuration:     DefaultOpenDuration,
+		HalfOpenProbes:   DefaultHalfOpenProbes,
+		flights:          newFlightGroup(),
+	}
+	for _, u := range append([]string{baseURL}, fallbacks...) {
+		c.endpoints = append(c.endpoints, &endpoint{baseURL: u, since: time.Now()})
+	}
+
+	return c
+}
+
+// Health returns the health of the base URLs, in order.
+func (c *HTTPClient) Health() []EndpointHealth {
+	health := make([]EndpointHealth, len(c.endpoints))
+	for i, e := range c.endpoints {
+		health[i] = e.health()
 	}
+
+	return health
+}
+
+// WithContext returns a copy of the client whose calls are bound to ctx.
+// A call shared with other callers is only cancelled once all of them are done.
+func (c *HTTPClient) WithContext(ctx context.Context) *HTTPClient {
+	client := *c
+	client.ctx = ctx
+
+	return &client
 }
 
 // ByName calls the country API filtered by country partial name or native name.
 // Optionally, we can filter the fields by name.
 // Returns the list of countries matching the filters.
 func (c *HTTPClient) ByName(name string, fields ...string) ([]Country, error) {
-	data, err := c.get(fmt.Sprintf("/name/%s%s", name, filter(queryDelimiter, fieldsFilter, fields...)))
+	data, err := c.get(fmt.Sprintf("/name/%s%s", url.PathEscape(name), filter(queryDelimiter, fieldsFilter, fields...)))
 	if err != nil {
 		return nil, err
 	}
@@ -48,7 +123,7 @@ func (c *HTTPClient) ByName(name string, fields ...string) ([]Country, error) {
 // Optionally, we can filter the fields by name.
 // Returns the list of countries matching the filters.
 func (c *HTTPClient) ByFullName(name string, fields ...string) ([]Country, error) {
-	data, err := c.get(fmt.Sprintf("/name/%s?fullText=true%s", name, filter(and, fieldsFilter, fields...)))
+	data, err := c.get(fmt.Sprintf("/name/%s?fullText=true%s", url.PathEscape(name), filter(and, fieldsFilter, fields...)))
 	if err != nil {
 		return nil, err
 	}
@@ -60,7 +135,7 @@ func (c *HTTPClient) ByFullName(name string, fields ...string) ([]Country, error
 // Optionally, we can filter the fields by name.
 // Returns the list of countries matching the filters.
 func (c *HTTPClient) ByCode(code string, fields ...string) ([]Country, error) {
-	data, err := c.get(fmt.Sprintf("/alpha/%s%s", code, filter(queryDelimiter, fieldsFilter, fields...)))
+	data, err := c.get(fmt.Sprintf("/alpha/%s%s", url.PathEscape(code), filter(queryDelimiter, fieldsFilter, fields...)))
 	if err != nil {
 		return nil, err
 	}
@@ -89,7 +164,7 @@ func (c *HTTPClient) ByCodes(codes []string, fields ...string) ([]Country, error
 // Optionally, we can filter the fields by name.
 // Returns the list of countries matching the filters.
 func (c *HTTPClient) ByCapital(name string, fields ...string) ([]Country, error) {
-	data, err := c.get(fmt.Sprintf("/capital/%s%s", name, filter(queryDelimiter, fieldsFilter, fields...)))
+	data, err := c.get(fmt.Sprintf("/capital/%s%s", url.PathEscape(name), filter(queryDelimiter, fieldsFilter, fields...)))
 	if err != nil {
 		return nil, err
 	}
@@ -113,7 +188,7 @@ func (c *HTTPClient) All(fields ...string) ([]Country, error) {
 // Optionally, we can filter the fields by name.
 // Returns the list of countries matching the filters.
 func (c *HTTPClient) ByCurrency(currency string, fields ...string) ([]Country, error) {
-	data, err := c.get(fmt.Sprintf("/currency/%s%s", currency, filter(queryDelimiter, fieldsFilter, fields...)))
+	data, err := c.get(fmt.Sprintf("/currency/%s%s", url.PathEscape(currency), filter(queryDelimiter, fieldsFilter, fields...)))
 	if err != nil {
 		return nil, err
 	}
@@ -125,7 +200,7 @@ func (c *HTTPClient) ByCurrency(currency string, fields ...string) ([]Country, e
 // Optionally, we can filter the fields by name.
 // Returns the list of countries matching the filters.
 func (c *HTTPClient) ByLanguage(language string, fields ...string) ([]Country, error) {
-	data, err := c.get(fmt.Sprintf("/lang/%s%s", language, filter(queryDelimiter, fieldsFilter, fields...)))
+	data, err := c.get(fmt.Sprintf("/lang/%s%s", url.PathEscape(language), filter(queryDelimiter, fieldsFilter, fields...)))
 	if err != nil {
 		return nil, err
 	}
@@ -137,7 +212,7 @@ func (c *HTTPClient) ByLanguage(language string, fields ...string) ([]Country, e
 // Optionally, we can filter the fields by name.
 // Returns the list of countries matching the filters.
 func (c *HTTPClient) ByCallingCode(callingCode string, fields ...string) ([]Country, error) {
-	data, err := c.get(fmt.Sprintf("/callingcode/%s%s", callingCode, filter(queryDelimiter, fieldsFilter, fields...)))
+	data, err := c.get(fmt.Sprintf("/callingcode/%s%s", url.PathEscape(callingCode), filter(queryDelimiter, fieldsFilter, fields...)))
 	if err != nil {
 		return nil, err
 	}
@@ -149,7 +224,7 @@ func (c *HTTPClient) ByCallingCode(callingCode string, fields ...string) ([]Coun
 // Optionally, we can filter the fields by name.
 // Returns the list of countries matching the filters.
 func (c *HTTPClient) ByRegion(region string, fields ...string) ([]Country, error) {
-	data, err := c.get(fmt.Sprintf("/region/%s%s", region, filter(queryDelimiter, fieldsFilter, fields...)))
+	data, err := c.get(fmt.Sprintf("/region/%s%s", url.PathEscape(region), filter(queryDelimiter, fieldsFilter, fields...)))
 	if err != nil {
 		return nil, err
 	}
@@ -161,7 +236,7 @@ func (c *HTTPClient) ByRegion(region string, fields ...string) ([]Country, error
 // Optionally, we can filter the fields by name.
 // Returns the list of countries matching the filters.
 func (c *HTTPClient) ByRegionalBloc(regionalBloc string, fields ...string) ([]Country, error) {
-	data, err := c.get(fmt.Sprintf("/regionalbloc/%s%s", regionalBloc, filter(queryDelimiter, fieldsFilter, fields...)))
+	data, err := c.get(fmt.Sprintf("/regionalbloc/%s%s", url.PathEscape(regionalBloc), filter(queryDelimiter, fieldsFilter, fields...)))
 	if err != nil {
 		return nil, err
 	}
@@ -170,16 +245,121 @@ func (c *HTTPClient) ByRegionalBloc(regionalBloc string, fields ...string) ([]Co
 }
 
 func (c *HTTPClient) get(endpoint string) ([]byte, error) {
-	url := fmt.Sprintf(c.baseURL+"%s", endpoint)
-	res, err := http.Get(url)
+	if c.flights == nil {
+		return nil, errUninitialized
+	}
+	var cached *CacheEntry
+	if c.Cache != nil {
+		entry, err := c.Cache.Get(endpoint)
+		if err != nil {
+			log.Println("Error reading the cache", err)
+		}
+		if entry != nil && !entry.Expired(time.Now()) {
+			return entry.Data, nil
+		}
+		cached = entry
+	}
+
+	body, err := c.coalesce(endpoint)
+	if err != nil {
+		// A caller that gave up gets its context error rather than a stale answer.
+		if c.ctx != nil && c.ctx.Err() != nil {
+			return nil, c.ctx.Err()
+		}
+		if cached != nil && unavailable(err) {
+			log.Println("Serving a stale response", err)
+			return cached.Data, nil
+		}
+		if c.Fallback != nil && unavailable(err) {
+			log.Println("Serving a fallback response", err)
+			return c.fallback(endpoint)
+		}
+		return body, err
+	}
+
+	return body, nil
+}
+
+// coalesce fetches the endpoint, sharing the call in progress for the same
+// endpoint, and stores the response in the Cache once per call.
+func (c *HTTPClient) coalesce(endpoint string) ([]byte, error) {
+	ctx := c.ctx
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	return c.flights.do(ctx, endpoint, func(ctx context.Context) ([]byte, error) {
+		body, err := c.fetch(ctx, endpoint)
+		if err == nil && c.Cache != nil {
+			if err := c.Cache.Set(endpoint, body); err != nil {
+				log.Println("Error writing the cache", err)
+			}
+		}
+		return body, err
+	})
+}
+
+// fetch calls the endpoint on the first available base URL, failing over to the
+// next ones while the API is unavailable.
+func (c *HTTPClient) fetch(ctx context.Context, endpoint string) ([]byte, error) {
+	probes, threshold := c.HalfOpenProbes, c.FailureThreshold
+	if probes < 1 {
+		probes = 1
+	}
+	if threshold < 1 {
+		threshold = 1
+	}
+
+	var lastErr error
+	for _, e := range c.endpoints {
+		allowed, t := e.allow(c.OpenDuration, probes)
+		c.notify(e, t)
+		if !allowed {
+			continue
+		}
+		body, err := c.fetchFrom(ctx, e.baseURL, endpoint)
+		switch {
+		case ctx.Err() != nil:
+			e.release()
+			return body, err
+		case err != nil && unavailable(err):
+			c.notify(e, e.failure(err, threshold))
+			lastErr = err
+			continue
+		}
+		c.notify(e, e.success(probes))
+		return body, err
+	}
+	if lastErr == nil {
+		log.Println("Skipping the API call", ErrCircuitOpen)
+		return []byte{}, ErrCircuitOpen
+	}
+
+	return []byte{}, lastErr
+}
+
+// notify calls OnStateChange for a change of state of the circuit of e.
+func (c *HTTPClient) notify(e *endpoint, t *transition) {
+	if t != nil && c.OnStateChange != nil {
+		c.OnStateChange(e.baseURL, t.from, t.to)
+	}
+}
+
+func (c *HTTPClient) fetchFrom(ctx context.Context, baseURL, endpoint string) ([]byte, error) {
+	url := fmt.Sprintf(baseURL+"%s", endpoint)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return []byte{}, err
+	}
+	res, err := c.Client.Do(req)
 	if err != nil {
 		log.Println("Error calling the API", err)
 		return []byte{}, err
 	}
 	defer res.Body.Close()
 
-	if res.StatusCode != 200 {
-		e := fmt.Errorf("Unexpected API status code %s", res.Status)
+	if res.StatusCode != http.StatusOK {
+		e := &StatusError{StatusCode: res.StatusCode, Status: res.Status}
 		log.Println("Unsucessfull call", e)
 		return []byte{}, e
 	}
@@ -193,6 +373,40 @@ func (c *HTTPClient) get(endpoint string) ([]byte, error) {
 	return body, nil
 }
 
+// fallback serves the endpoint with the Fallback handler.
+func (c *HTTPClient) fallback(endpoint string) ([]byte, error) {
+	req, err := http.NewRequest(http.MethodGet, endpoint, nil)
+	if err != nil {
+		return []byte{}, err
+	}
+	w := &fallbackWriter{header: http.Header{}, status: http.StatusOK}
+	c.Fallback.ServeHTTP(w, req)
+	if w.status != http.StatusOK {
+		return []byte{}, &StatusError{StatusCode: w.status, Status: fmt.Sprintf("%d %s", w.status, http.StatusText(w.status))}
+	}
+
+	return w.body.Bytes(), nil
+}
+
+// fallbackWriter records the response of the Fallback handler.
+type fallbackWriter struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+}
+
+func (w *fallbackWriter) Header() http.Header {
+	return w.header
+}
+
+func (w *fallbackWriter) Write(data []byte) (int, error) {
+	return w.body.Write(data)
+}
+
+func (w *fallbackWriter) WriteHeader(status int) {
+	w.status = status
+}
+
 func filter(prefix, fieldName string, fields ...string) string {
 	if fields == nil {
 		return ""
@@ -203,7 +417,7 @@ func filter(prefix, fieldName string, fields ...string) string {
 	sb.WriteString(fieldName)
 	sb.WriteString("=")
 	for i := 0; i < len(fields); i++ {
-		sb.WriteString(fields[i])
+		sb.WriteString(url.QueryEscape(fields[i]))
 		if i != len(fields)-1 {
 			sb.WriteString(";")
 		}
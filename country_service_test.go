@@ -7,6 +7,7 @@ import (
 	"net/http/httptest"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/georgesafta/countries"
 )
@@ -593,3 +594,31 @@ func handleMarshalError(w http.ResponseWriter, r *http.Request) {
 func handleBadCall(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusBadRequest)
 }
+
+func TestStatusError(t *testing.T) {
+	ts := httptest.NewServer(http.NotFoundHandler())
+	defer ts.Close()
+
+	client := countries.NewHTTPClient(ts.URL)
+	_, err := client.ByName("test")
+	statusErr, ok := err.(*countries.StatusError)
+	if !ok || statusErr.StatusCode != http.StatusNotFound {
+		t.Fatalf("Expected not found status error, got %v", err)
+	}
+	if statusErr.Error() != "Unexpected API status code 404 Not Found" {
+		t.Fatalf("Unexpected error message %q", statusErr.Error())
+	}
+}
+
+func TestClientTimeout(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(200 * time.Millisecond)
+	}))
+	defer ts.Close()
+
+	client := countries.NewHTTPClient(ts.URL)
+	client.Client.Timeout = 10 * time.Millisecond
+	if _, err := client.All(); err == nil {
+		t.Fatal("Expected the client timeout to be used")
+	}
+}
@@ -0,0 +1,190 @@
+package countries
+
+import (
+	"sort"
+	"strings"
+)
+
+// CurrencyInfo is a currency of the catalog, with the countries using it.
+// Name and Symbol are the most common values listed by the countries,
+// every other value found is kept in Names and Symbols.
+type CurrencyInfo struct {
+	Code      string
+	Name      string
+	Symbol    string
+	Names     []string
+	Symbols   []string
+	Countries []string
+}
+
+// Shared returns true when the currency is used by more than one country.
+func (c CurrencyInfo) Shared() bool {
+	return len(c.Countries) > 1
+}
+
+// CurrencyCatalog indexes the currencies of a set of countries by ISO 4217 code.
+type CurrencyCatalog struct {
+	currencies map[string]*CurrencyInfo
+	countries  map[string]Country
+	bySymbol   map[string][]string
+}
+
+// NewCurrencyCatalog returns a new CurrencyCatalog built from the Currencies of the given countries.
+// Currencies without a code are left out.
+func NewCurrencyCatalog(countries []Country) *CurrencyCatalog {
+	catalog := &CurrencyCatalog{
+		currencies: map[string]*CurrencyInfo{},
+		countries:  make(map[string]Country, len(countries)),
+		bySymbol:   map[string][]string{},
+	}
+	names := map[string]map[string]int{}
+	symbols := map[string]map[string]int{}
+	for _, c := range countries {
+		catalog.countries[strings.ToUpper(c.Alpha3Code)] = c
+		for _, cur := range c.Currencies {
+			code := currencyCode(cur)
+			if code == "" {
+				continue
+			}
+			info, ok := catalog.currencies[code]
+			if !ok {
+				info = &CurrencyInfo{Code: code}
+				catalog.currencies[code] = info
+				names[code] = map[string]int{}
+				symbols[code] = map[string]int{}
+			}
+			if !containsCode(info.Countries, c.Alpha3Code) {
+				info.Countries = append(info.Countries, c.Alpha3Code)
+			}
+			if cur.Name != "" {
+				names[code][cur.Name]++
+			}
+			if cur.Symbol != "" {
+				symbols[code][cur.Symbol]++
+			}
+		}
+	}
+
+	for code, info := range catalog.currencies {
+		info.Names = byFrequency(names[code])
+		info.Symbols = byFrequency(symbols[code])
+		if len(info.Names) > 0 {
+			info.Name = info.Names[0]
+		}
+		if len(info.Symbols) > 0 {
+			info.Symbol = info.Symbols[0]
+		}
+		for _, s := range info.Symbols {
+			catalog.bySymbol[s] = append(catalog.bySymbol[s], code)
+		}
+		sort.Strings(info.Countries)
+	}
+	for s := range catalog.bySymbol {
+		sort.Strings(catalog.bySymbol[s])
+	}
+
+	return catalog
+}
+
+// Currency returns the currency with the given ISO 4217 code.
+func (c *CurrencyCatalog) Currency(code string) (CurrencyInfo, bool) {
+	info, ok := c.currencies[strings.ToUpper(code)]
+	if !ok {
+		return CurrencyInfo{}, false
+	}
+
+	return *info, true
+}
+
+// Currencies returns every currency of the catalog, sorted by code.
+func (c *CurrencyCatalog) Currencies() []CurrencyInfo {
+	currencies := make([]CurrencyInfo, 0, len(c.currencies))
+	for _, info := range c.currencies {
+		currencies = append(currencies, *info)
+	}
+	sort.Slice(currencies, func(i, j int) bool {
+		return currencies[i].Code < currencies[j].Code
+	})
+
+	return currencies
+}
+
+// Shared returns the currencies used by more than one country, sorted by code.
+func (c *CurrencyCatalog) Shared() []CurrencyInfo {
+	var shared []CurrencyInfo
+	for _, info := range c.Currencies() {
+		if info.Shared() {
+			shared = append(shared, info)
+		}
+	}
+
+	return shared
+}
+
+// Countries returns the countries using the currency with the given ISO 4217 code, sorted by code.
+func (c *CurrencyCatalog) Countries(code string) []Country {
+	info, ok := c.currencies[strings.ToUpper(code)]
+	if !ok {
+		return nil
+	}
+
+	countries := make([]Country, len(info.Countries))
+	for i, alpha3 := range info.Countries {
+		countries[i] = c.countries[strings.ToUpper(alpha3)]
+	}
+
+	return countries
+}
+
+// PrimaryCurrency returns the first currency listed by the country with the given alpha-3 code.
+func (c *CurrencyCatalog) PrimaryCurrency(alpha3 string) (CurrencyInfo, bool) {
+	country, ok := c.countries[strings.ToUpper(alpha3)]
+	if !ok {
+		return CurrencyInfo{}, false
+	}
+	for _, cur := range country.Currencies {
+		if code := currencyCode(cur); code != "" {
+			return c.Currency(code)
+		}
+	}
+
+	return CurrencyInfo{}, false
+}
+
+// BySymbol returns the currencies written with the given symbol, sorted by code.
+func (c *CurrencyCatalog) BySymbol(symbol string) []CurrencyInfo {
+	codes := c.bySymbol[strings.TrimSpace(symbol)]
+	currencies := make([]CurrencyInfo, len(codes))
+	for i, code := range codes {
+		currencies[i] = *c.currencies[code]
+	}
+
+	return currencies
+}
+
+// currencyCode returns the normalised code of the currency, or an empty
+// string for the placeholders the API uses for missing codes.
+func currencyCode(c Currency) string {
+	code := strings.ToUpper(strings.TrimSpace(c.Code))
+	if code == "(NONE)" {
+		return ""
+	}
+
+	return code
+}
+
+// byFrequency returns the values sorted by decreasing count, then alphabetically.
+func byFrequency(counts map[string]int) []string {
+	values := make([]string, 0, len(counts))
+	for v := range counts {
+		values = append(values, v)
+	}
+	sort.Slice(values, func(i, j int) bool {
+		if counts[values[i]] != counts[values[j]] {
+			return counts[values[i]] > counts[values[j]]
+		}
+		return values[i] < values[j]
+	})
+
+	return values
+}
@@ -0,0 +1,89 @@
+package countries_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/georgesafta/countries"
+)
+
+func TestCurrencyCatalogCountries(t *testing.T) {
+	catalog := countries.NewCurrencyCatalog(loadDataset(t))
+
+	var codes []string
+	for _, c := range catalog.Countries("xof") {
+		codes = append(codes, c.Alpha3Code)
+	}
+	if !reflect.DeepEqual([]string{"CIV", "SEN"}, codes) {
+		t.Fatalf("Expected XOF countries CIV and SEN, got %v", codes)
+	}
+	if catalog.Countries("XXX") != nil {
+		t.Fatal("Expected no countries for unknown currency")
+	}
+}
+
+func TestCurrencyCatalogPrimaryCurrency(t *testing.T) {
+	catalog := countries.NewCurrencyCatalog(loadDataset(t))
+
+	primary, ok := catalog.PrimaryCurrency("PAN")
+	if !ok || primary.Code != "PAB" || primary.Symbol != "B/." {
+		t.Fatalf("Expected PAB as primary currency of PAN, got %v", primary)
+	}
+	if _, ok := catalog.PrimaryCurrency("XXX"); ok {
+		t.Fatal("Expected no primary currency for unknown country")
+	}
+}
+
+func TestCurrencyCatalogShared(t *testing.T) {
+	catalog := countries.NewCurrencyCatalog(loadDataset(t))
+
+	var codes []string
+	for _, c := range catalog.Shared() {
+		codes = append(codes, c.Code)
+	}
+	if !reflect.DeepEqual([]string{"AUD", "EUR", "GBP", "USD", "XOF"}, codes) {
+		t.Fatalf("Unexpected shared currencies %v", codes)
+	}
+
+	usd, _ := catalog.Currency("usd")
+	if !reflect.DeepEqual([]string{"ECU", "PAN", "PRI", "USA"}, usd.Countries) {
+		t.Fatalf("Unexpected USD countries %v", usd.Countries)
+	}
+	if len(catalog.Currencies()) != 21 {
+		t.Fatalf("Expected 21 distinct currencies, got %d", len(catalog.Currencies()))
+	}
+}
+
+func TestCurrencyCatalogBySymbol(t *testing.T) {
+	catalog := countries.NewCurrencyCatalog(loadDataset(t))
+
+	var codes []string
+	for _, c := range catalog.BySymbol("$") {
+		codes = append(codes, c.Code)
+	}
+	if !reflect.DeepEqual([]string{"AUD", "CAD", "COP", "MXN", "TVD", "USD"}, codes) {
+		t.Fatalf("Unexpected currencies for $ %v", codes)
+	}
+	if len(catalog.BySymbol("?")) != 0 {
+		t.Fatal("Expected no currencies for unknown symbol")
+	}
+}
+
+func TestCurrencyCatalogConflicts(t *testing.T) {
+	catalog := countries.NewCurrencyCatalog([]countries.Country{
+		{Alpha3Code: "AAA", Currencies: []countries.Currency{{Code: "EUR", Name: "Euro", Symbol: "€"}}},
+		{Alpha3Code: "BBB", Currencies: []countries.Currency{{Code: "eur", Name: "euro", Symbol: "EUR"}}},
+		{Alpha3Code: "CCC", Currencies: []countries.Currency{{Code: "EUR", Name: "Euro", Symbol: "€"}, {Code: "(none)", Name: "Local"}}},
+	})
+
+	eur, ok := catalog.Currency("EUR")
+	if !ok || eur.Name != "Euro" || eur.Symbol != "€" {
+		t.Fatalf("Expected the most common name and symbol, got %v", eur)
+	}
+	if !reflect.DeepEqual([]string{"Euro", "euro"}, eur.Names) || !reflect.DeepEqual([]string{"€", "EUR"}, eur.Symbols) {
+		t.Fatalf("Expected conflicting values to be kept, got %v and %v", eur.Names, eur.Symbols)
+	}
+	if len(catalog.Currencies()) != 1 {
+		t.Fatal("Expected placeholder currency codes to be skipped")
+	}
+}
@@ -0,0 +1,389 @@
+package countries
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// ImportError is an error parsing a value of an imported row.
+// Rows are numbered from 1 and include the CSV header, so they match
+// spreadsheet and editor line numbers.
+type ImportError struct {
+	Row    int
+	Column string
+	Err    error
+}
+
+func (e *ImportError) Error() string {
+	if e.Column == "" {
+		return fmt.Sprintf("Row %d: %v", e.Row, e.Err)
+	}
+
+	return fmt.Sprintf("Row %d, column %s: %v", e.Row, e.Column, e.Err)
+}
+
+// ImportErrors holds every error of an import.
+type ImportErrors []*ImportError
+
+func (e ImportErrors) Error() string {
+	messages := make([]string, len(e))
+	for i, err := range e {
+		messages[i] = err.Error()
+	}
+
+	return strings.Join(messages, "\n")
+}
+
+// Decoder reads countries written by the Encoder in CSV, TSV or NDJSON.
+//
+// CSV and TSV headers are column names, as written by the Encoder, and nested
+// values are rebuilt from the flattened columns. Mapping renames other headers
+// to column names, e.g. "Country" to name, and headers mapped to an empty
+// string are skipped. Reading what the Encoder wrote with the default columns
+// gives back the same countries, except that empty top level lists are read as
+// missing ones, which the API does not distinguish.
+type Decoder struct {
+	Mapping map[string]string
+	r       io.Reader
+	format  Format
+}
+
+// NewDecoder returns a new Decoder reading from r.
+func NewDecoder(r io.Reader, format Format) (*Decoder, error) {
+	switch format {
+	case FormatCSV, FormatTSV, FormatNDJSON:
+		return &Decoder{Mapping: map[string]string{}, r: r, format: format}, nil
+	}
+
+	return nil, fmt.Errorf("Unsupported import format %q", format)
+}
+
+// Decode reads every country.
+// Rows with invalid values are left out and reported in an ImportErrors,
+// returned along with the valid countries.
+func (d *Decoder) Decode() ([]Country, error) {
+	if d.format == FormatNDJSON {
+		return d.decodeNDJSON()
+	}
+
+	return d.decodeCSV()
+}
+
+func (d *Decoder) decodeCSV() ([]Country, error) {
+	r := csv.NewReader(d.r)
+	if d.format == FormatTSV {
+		r.Comma = '\t'
+	}
+
+	header, err := r.Read()
+	if err == io.EOF {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, ImportErrors{csvImportError(err, 1)}
+	}
+
+	var errs ImportErrors
+	columns := make([]*column, len(header))
+	for i, h := range header {
+		name, mapped := d.Mapping[h]
+		if !mapped {
+			name = h
+		}
+		if name == "" {
+			continue
+		}
+		col, err := parseColumn(name)
+		if err != nil {
+			errs = append(errs, &ImportError{Row: 1, Column: h, Err: err})
+			continue
+		}
+		columns[i] = &col
+	}
+	if errs != nil {
+		return nil, errs
+	}
+
+	var countries []Country
+	for row := 2; ; row++ {
+		record, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			errs = append(errs, csvImportError(err, row))
+			if parseErr, ok := err.(*csv.ParseError); ok && parseErr.Err == csv.ErrFieldCount {
+				continue
+			}
+			break
+		}
+
+		c := Country{}
+		v := reflect.ValueOf(&c).Elem()
+		valid := true
+		for i, value := range record {
+			if columns[i] == nil {
+				continue
+			}
+			if err := columns[i].set(v, value); err != nil {
+				errs = append(errs, &ImportError{Row: row, Column: header[i], Err: err})
+				valid = false
+			}
+		}
+		if valid {
+			fillNestedLists(v)
+			countries = append(countries, c)
+		}
+	}
+	if errs != nil {
+		return countries, errs
+	}
+
+	return countries, nil
+}
+
+func (d *Decoder) decodeNDJSON() ([]Country, error) {
+	var countries []Country
+	var errs ImportErrors
+	r := bufio.NewReader(d.r)
+	for row := 1; ; row++ {
+		line, err := r.ReadBytes('\n')
+		if len(bytes.TrimSpace(line)) > 0 {
+			c := Country{}
+			if err := json.Unmarshal(line, &c); err != nil {
+				e := &ImportError{Row: row, Err: err}
+				if typeErr, ok := err.(*json.UnmarshalTypeError); ok {
+					e.Column = typeErr.Field
+				}
+				errs = append(errs, e)
+			} else {
+				countries = append(countries, c)
+			}
+		}
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			errs = append(errs, &ImportError{Row: row, Err: err})
+			break
+		}
+	}
+	if errs != nil {
+		return countries, errs
+	}
+
+	return countries, nil
+}
+
+func csvImportError(err error, row int) *ImportError {
+	if parseErr, ok := err.(*csv.ParseError); ok {
+		return &ImportError{Row: parseErr.Line, Err: parseErr.Err}
+	}
+
+	return &ImportError{Row: row, Err: err}
+}
+
+// set parses the flattened value of the column into the country.
+func (col column) set(country reflect.Value, s string) error {
+	v := country.Field(col.field.index)
+	switch {
+	case col.sub == "":
+		return setFlatValue(v, s)
+	case v.Kind() == reflect.Map:
+		if s == "" {
+			return nil
+		}
+		if v.IsNil() {
+			v.Set(reflect.MakeMap(v.Type()))
+		}
+		v.SetMapIndex(reflect.ValueOf(col.sub), reflect.ValueOf(s))
+		return nil
+	}
+
+	sub, _ := fieldByName(v.Type().Elem(), col.sub)
+	items := splitValues(s, listSeparator)
+	growSlice(v, len(items))
+	for i, item := range items {
+		if err := setFlatItem(v.Index(i).Field(sub.index), item); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// setFlatValue parses a top level value flattened by flatValue.
+func setFlatValue(v reflect.Value, s string) error {
+	switch v.Kind() {
+	case reflect.Slice:
+		items := splitValues(s, listSeparator)
+		growSlice(v, len(items))
+		for i, item := range items {
+			target := v.Index(i)
+			if target.Kind() == reflect.Struct {
+				target = target.Field(0)
+			}
+			if err := setScalar(target, unescapeValue(item)); err != nil {
+				return err
+			}
+		}
+		return nil
+	case reflect.Map:
+		if s == "" {
+			return nil
+		}
+		m := reflect.MakeMap(v.Type())
+		for _, pair := range splitEscaped(s, listSeparator) {
+			kv := splitEscaped(pair, pairSeparator)
+			if len(kv) != 2 {
+				return fmt.Errorf("Invalid key=value pair %q", pair)
+			}
+			m.SetMapIndex(reflect.ValueOf(unescapeValue(kv[0])), reflect.ValueOf(unescapeValue(kv[1])))
+		}
+		v.Set(m)
+		return nil
+	default:
+		return setScalar(v, s)
+	}
+}
+
+// setFlatItem parses a field of a list item flattened by flatItem.
+func setFlatItem(v reflect.Value, s string) error {
+	if v.Kind() != reflect.Slice {
+		return setScalar(v, unescapeValue(s))
+	}
+	items := splitValues(s, itemSeparator)
+	v.Set(reflect.MakeSlice(v.Type(), len(items), len(items)))
+	for i, item := range items {
+		if err := setScalar(v.Index(i), unescapeValue(item)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func setScalar(v reflect.Value, s string) error {
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(s)
+		return nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if s == "" {
+			v.SetInt(0)
+			return nil
+		}
+		n, err := strconv.ParseInt(strings.TrimSpace(s), 10, v.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("Invalid integer %q", s)
+		}
+		v.SetInt(n)
+		return nil
+	case reflect.Float32, reflect.Float64:
+		if s == "" {
+			v.SetFloat(0)
+			return nil
+		}
+		f, err := strconv.ParseFloat(strings.TrimSpace(s), v.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("Invalid number %q", s)
+		}
+		v.SetFloat(f)
+		return nil
+	case reflect.Bool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return fmt.Errorf("Invalid boolean %q", s)
+		}
+		v.SetBool(b)
+		return nil
+	}
+
+	return fmt.Errorf("Unsupported value type %s", v.Type())
+}
+
+// fillNestedLists replaces the nil lists of list items by empty ones, as the API
+// always returns them, e.g. the otherAcronyms of a regional bloc.
+func fillNestedLists(country reflect.Value) {
+	for _, f := range countryFields {
+		v := country.Field(f.index)
+		if v.Kind() != reflect.Slice || v.Type().Elem().Kind() != reflect.Struct {
+			continue
+		}
+		for i := 0; i < v.Len(); i++ {
+			item := v.Index(i)
+			for _, sub := range jsonFields(item.Type()) {
+				if field := item.Field(sub.index); field.Kind() == reflect.Slice && field.IsNil() && !sub.omitEmpty {
+					field.Set(reflect.MakeSlice(field.Type(), 0, 0))
+				}
+			}
+		}
+	}
+}
+
+// growSlice extends the slice to at least n items, keeping the existing ones.
+func growSlice(v reflect.Value, n int) {
+	if v.Len() >= n {
+		return
+	}
+	grown := reflect.MakeSlice(v.Type(), n, n)
+	reflect.Copy(grown, v)
+	v.Set(grown)
+}
+
+// splitValues splits a list joined by joinValues, keeping the escapes.
+func splitValues(s string, sep rune) []string {
+	if s == singleEmptyValues[sep] {
+		return []string{""}
+	}
+
+	return splitEscaped(s, sep)
+}
+
+// splitEscaped splits s on the separators not escaped with a backslash,
+// keeping the escapes. An empty string has no values.
+func splitEscaped(s string, sep rune) []string {
+	if s == "" {
+		return nil
+	}
+	var values []string
+	start, escaped := 0, false
+	for i, r := range s {
+		switch {
+		case escaped:
+			escaped = false
+		case r == escapeChar:
+			escaped = true
+		case r == sep:
+			values = append(values, s[start:i])
+			start = i + 1
+		}
+	}
+
+	return append(values, s[start:])
+}
+
+func unescapeValue(s string) string {
+	if !strings.ContainsRune(s, escapeChar) {
+		return s
+	}
+	sb := strings.Builder{}
+	escaped := false
+	for _, r := range s {
+		if r == escapeChar && !escaped {
+			escaped = true
+			continue
+		}
+		escaped = false
+		sb.WriteRune(r)
+	}
+
+	return sb.String()
+}
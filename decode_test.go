@@ -0,0 +1,142 @@
+package countries_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/georgesafta/countries"
+)
+
+func decode(t *testing.T, format countries.Format, data string, mapping map[string]string) ([]countries.Country, error) {
+	d, err := countries.NewDecoder(strings.NewReader(data), format)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for k, v := range mapping {
+		d.Mapping[k] = v
+	}
+
+	return d.Decode()
+}
+
+func TestDecodeRoundTrip(t *testing.T) {
+	// Lists holding a single empty value and empty list items are kept.
+	empty := countries.Country{
+		Name:           "Empty",
+		TopLevelDomain: []string{""},
+		Currencies:     []countries.Currency{{}},
+		RegionalBlocs:  []countries.RegionalBloc{{OtherAcronyms: []string{""}, OtherNames: []string{}}},
+	}
+	data := append(loadDataset(t), encodedCountry, empty)
+	expected, _ := json.Marshal(data)
+	// Flat formats decode the nil lists of list items as empty ones, as the API returns them.
+	blocs := append([]countries.RegionalBloc(nil), encodedCountry.RegionalBlocs...)
+	blocs[1].OtherNames = []string{}
+	flatCountry := encodedCountry
+	flatCountry.RegionalBlocs = blocs
+	flatExpected, _ := json.Marshal(append(loadDataset(t), flatCountry, empty))
+
+	for _, format := range []countries.Format{countries.FormatCSV, countries.FormatTSV, countries.FormatNDJSON} {
+		buf := bytes.Buffer{}
+		e, _ := countries.NewEncoder(&buf, format)
+		if err := e.Encode(data); err != nil {
+			t.Fatal(err)
+		}
+
+		decoded, err := decode(t, format, buf.String(), nil)
+		if err != nil {
+			t.Fatalf("Unexpected %s error %v", format, err)
+		}
+		expected := expected
+		if format != countries.FormatNDJSON {
+			expected = flatExpected
+		}
+		if actual, _ := json.Marshal(decoded); !bytes.Equal(actual, expected) {
+			t.Fatalf("Expected lossless %s round trip, got:\n%s", format, actual)
+		}
+	}
+}
+
+func TestDecodeCSVColumns(t *testing.T) {
+	data := "Country,alpha3Code,currencies.code,currencies.symbol,translations.de,regionalBlocs.otherNames,Notes\n" +
+		"Colombia,COL,COP;USD,$,Kolumbien,Alianza del Pacífico|AP\n" +
+		"Panama,PAN,,,,,x\n"
+	result, err := decode(t, countries.FormatCSV, strings.Replace(data, "|AP\n", "|AP,\n", 1), map[string]string{"Country": "name", "Notes": ""})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []countries.Country{
+		{
+			Name:         "Colombia",
+			Alpha3Code:   "COL",
+			Currencies:   []countries.Currency{{Code: "COP", Symbol: "$"}, {Code: "USD"}},
+			Translations: map[string]string{"de": "Kolumbien"},
+			RegionalBlocs: []countries.RegionalBloc{
+				{OtherAcronyms: []string{}, OtherNames: []string{"Alianza del Pacífico", "AP"}},
+			},
+		},
+		{Name: "Panama", Alpha3Code: "PAN"},
+	}
+	if !reflect.DeepEqual(result, expected) {
+		t.Fatalf("Expected %+v, got %+v", expected, result)
+	}
+}
+
+func TestDecodeCSVErrors(t *testing.T) {
+	_, err := decode(t, countries.FormatCSV, "name,Capital City,rate\n", nil)
+	if err == nil || err.Error() != "Row 1, column Capital City: Unknown column \"Capital City\"\nRow 1, column rate: Unknown column \"rate\"" {
+		t.Fatalf("Expected header errors, got %v", err)
+	}
+
+	data := "name,population,area,translations\n" +
+		"Colombia,48759958,1141748,de=Kolumbien\n" +
+		"Peru,many,1285216,es=Perú\n" +
+		"Chile,18191900\n" +
+		"Brazil,206135893,8515767,pt\n" +
+		"Ecuador,16545799,x,\n"
+	result, err := decode(t, countries.FormatCSV, data, nil)
+	if len(result) != 1 || result[0].Name != "Colombia" {
+		t.Fatalf("Expected only the valid row, got %v", result)
+	}
+	errs, ok := err.(countries.ImportErrors)
+	if !ok || len(errs) != 4 {
+		t.Fatalf("Expected 4 import errors, got %v", err)
+	}
+	for i, expected := range []string{
+		`Row 3, column population: Invalid integer "many"`,
+		`Row 4: wrong number of fields`,
+		`Row 5, column translations: Invalid key=value pair "pt"`,
+		`Row 6, column area: Invalid number "x"`,
+	} {
+		if errs[i].Error() != expected {
+			t.Fatalf("Expected %q, got %q", expected, errs[i].Error())
+		}
+	}
+}
+
+func TestDecodeNDJSON(t *testing.T) {
+	data := `{"name":"Colombia","alpha3Code":"COL"}
+
+{"name":"Peru","population":"many"}
+not json
+{"name":"Chile"}`
+	result, err := decode(t, countries.FormatNDJSON, data, nil)
+	if len(result) != 2 || result[0].Name != "Colombia" || result[1].Name != "Chile" {
+		t.Fatalf("Unexpected countries %v", result)
+	}
+	errs, ok := err.(countries.ImportErrors)
+	if !ok || len(errs) != 2 || errs[0].Row != 3 || errs[0].Column != "population" || errs[1].Row != 4 {
+		t.Fatalf("Unexpected errors %v", err)
+	}
+
+	if _, err := countries.NewDecoder(strings.NewReader(""), countries.FormatYAML); err == nil {
+		t.Fatal("Expected unsupported format error")
+	}
+	if result, err := decode(t, countries.FormatCSV, "", nil); result != nil || err != nil {
+		t.Fatalf("Expected empty import, got %v, %v", result, err)
+	}
+}
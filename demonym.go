@@ -0,0 +1,132 @@
+package countries
+
+import (
+	"sort"
+	"strings"
+)
+
+// MatchDemonym is the field of a Resolution made by the DemonymIndex.
+const MatchDemonym MatchField = "demonym"
+
+// DemonymIndex maps countries to their Demonym and demonyms back to countries.
+// Lookups ignore case, accents and punctuation, and accept plurals such as
+// "Colombians". Multi-word demonyms are matched as a whole, and a demonym
+// shared by several countries resolves to all of them.
+type DemonymIndex struct {
+	demonyms  map[string][]string
+	countries map[string]Country
+}
+
+// NewDemonymIndex returns a new DemonymIndex built from the Demonym of the given countries.
+func NewDemonymIndex(countries []Country) *DemonymIndex {
+	idx := &DemonymIndex{
+		demonyms:  map[string][]string{},
+		countries: make(map[string]Country, len(countries)),
+	}
+	for _, c := range countries {
+		code := strings.ToUpper(c.Alpha3Code)
+		idx.countries[code] = c
+		if strings.TrimSpace(c.Demonym) == "" {
+			continue
+		}
+		for _, key := range []string{fold(c.Demonym), fold(PluralDemonym(c.Demonym))} {
+			if !containsCode(idx.demonyms[key], code) {
+				idx.demonyms[key] = append(idx.demonyms[key], code)
+			}
+		}
+	}
+	for key := range idx.demonyms {
+		sort.Strings(idx.demonyms[key])
+	}
+
+	return idx
+}
+
+// Demonym returns the demonym of the country with the given alpha-3 code.
+// Returns false when the country is unknown or has no demonym.
+func (idx *DemonymIndex) Demonym(alpha3 string) (string, bool) {
+	c, ok := idx.countries[strings.ToUpper(alpha3)]
+	if !ok || c.Demonym == "" {
+		return "", false
+	}
+
+	return c.Demonym, true
+}
+
+// Plural returns the plural demonym of the country with the given alpha-3 code.
+func (idx *DemonymIndex) Plural(alpha3 string) (string, bool) {
+	d, ok := idx.Demonym(alpha3)
+	if !ok {
+		return "", false
+	}
+
+	return PluralDemonym(d), true
+}
+
+// Lookup returns every country with the given demonym, singular or plural, sorted by alpha-3 code.
+func (idx *DemonymIndex) Lookup(demonym string) []Country {
+	codes := idx.demonyms[fold(demonym)]
+	countries := make([]Country, len(codes))
+	for i, code := range codes {
+		countries[i] = idx.countries[code]
+	}
+
+	return countries
+}
+
+// Resolve returns the country with the given demonym, singular or plural.
+// Returns a *NoMatchError when no country matches and an *AmbiguousError
+// when the demonym is shared by several countries.
+func (idx *DemonymIndex) Resolve(demonym string) (Resolution, error) {
+	countries := idx.Lookup(demonym)
+	switch len(countries) {
+	case 0:
+		return Resolution{}, &NoMatchError{Input: demonym}
+	case 1:
+		return Resolution{Country: countries[0], Field: MatchDemonym, Confidence: 1}, nil
+	}
+
+	candidates := make([]Resolution, len(countries))
+	for i, c := range countries {
+		candidates[i] = Resolution{Country: c, Field: MatchDemonym, Confidence: 1}
+	}
+
+	return Resolution{}, &AmbiguousError{Input: demonym, Candidates: candidates}
+}
+
+// invariableDemonyms holds the demonyms ending in ch that have no plural form.
+// Others, such as Czech, take an s.
+var invariableDemonyms = map[string]bool{
+	"french": true,
+	"dutch":  true,
+}
+
+// PluralDemonym returns the English plural of a demonym, pluralizing its last word.
+// Demonyms ending in a sibilant, such as Swiss, Irish, French or Chinese, are invariable
+// and compounds such as Frenchman become Frenchmen, while German becomes Germans and
+// Czech becomes Czechs.
+func PluralDemonym(demonym string) string {
+	d := strings.TrimSpace(demonym)
+	lower := strings.ToLower(d)
+	word := lower[strings.LastIndexAny(lower, " -")+1:]
+	switch {
+	case d == "":
+		return ""
+	case strings.HasSuffix(lower, "shman"), strings.HasSuffix(lower, "chman"), strings.HasSuffix(lower, "tsman"):
+		return d[:len(d)-2] + matchCase("en", d[len(d)-1:])
+	case strings.HasSuffix(lower, "ese"), strings.HasSuffix(lower, "s"), strings.HasSuffix(lower, "sh"),
+		invariableDemonyms[word], strings.HasSuffix(lower, "x"), strings.HasSuffix(lower, "z"):
+		return d
+	default:
+		return d + matchCase("s", d[len(d)-1:])
+	}
+}
+
+// matchCase returns the suffix upper cased when the last letter is upper case.
+func matchCase(suffix, last string) string {
+	if last != strings.ToLower(last) {
+		return strings.ToUpper(suffix)
+	}
+
+	return suffix
+}
@@ -0,0 +1,92 @@
+package countries_test
+
+import (
+	"testing"
+
+	"github.com/georgesafta/countries"
+)
+
+func TestDemonym(t *testing.T) {
+	idx := countries.NewDemonymIndex(loadDataset(t))
+
+	if d, ok := idx.Demonym("col"); !ok || d != "Colombian" {
+		t.Fatalf("Expected Colombian, got %q", d)
+	}
+	if _, ok := idx.Demonym("ATA"); ok {
+		t.Fatal("Expected no demonym for Antarctica")
+	}
+	if p, ok := idx.Plural("KOR"); !ok || p != "South Koreans" {
+		t.Fatalf("Expected South Koreans, got %q", p)
+	}
+}
+
+func TestPluralDemonym(t *testing.T) {
+	for demonym, expected := range map[string]string{
+		"Colombian":    "Colombians",
+		"Chinese":      "Chinese",
+		"Swiss":        "Swiss",
+		"Irish":        "Irish",
+		"French":       "French",
+		"Dutch":        "Dutch",
+		"Swiss French": "Swiss French",
+		"Czech":        "Czechs",
+		"Dutchman":     "Dutchmen",
+		"German":       "Germans",
+		"Puerto Rican": "Puerto Ricans",
+		"INDIAN":       "INDIANS",
+		"":             "",
+	} {
+		if p := countries.PluralDemonym(demonym); p != expected {
+			t.Fatalf("Expected %q for %q, got %q", expected, demonym, p)
+		}
+	}
+}
+
+func TestDemonymResolve(t *testing.T) {
+	idx := countries.NewDemonymIndex(loadDataset(t))
+
+	for input, expected := range map[string]string{
+		"Colombian":     "COL",
+		"colombians":    "COL",
+		"COLOMBIAN":     "COL",
+		"puerto-ricans": "PRI",
+		"South Korean":  "KOR",
+		"Swiss":         "CHE",
+		"ivorians":      "CIV",
+	} {
+		r, err := idx.Resolve(input)
+		if err != nil || r.Country.Alpha3Code != expected || r.Field != countries.MatchDemonym {
+			t.Fatalf("Expected %s for %q, got %v, %v", expected, input, r, err)
+		}
+	}
+
+	if _, err := idx.Resolve("Korean"); err == nil {
+		t.Fatal("Expected no match for a partial multi-word demonym")
+	} else if _, ok := err.(*countries.NoMatchError); !ok {
+		t.Fatalf("Expected NoMatchError, got %v", err)
+	}
+}
+
+func TestDemonymShared(t *testing.T) {
+	idx := countries.NewDemonymIndex([]countries.Country{
+		{Alpha3Code: "COG", Demonym: "Congolese"},
+		{Alpha3Code: "COD", Demonym: "Congolese"},
+		{Alpha3Code: "FRA", Demonym: "French"},
+		{Alpha3Code: "CZE", Demonym: "Czech"},
+	})
+
+	if cs := idx.Lookup("congolese"); len(cs) != 2 || cs[0].Alpha3Code != "COD" || cs[1].Alpha3Code != "COG" {
+		t.Fatalf("Expected COD and COG, got %v", cs)
+	}
+	_, err := idx.Resolve("Congolese")
+	if _, ok := err.(*countries.AmbiguousError); !ok || err.Error() != `Ambiguous input "Congolese", matching COD, COG` {
+		t.Fatalf("Expected AmbiguousError, got %v", err)
+	}
+
+	if r, err := idx.Resolve("czechs"); err != nil || r.Country.Alpha3Code != "CZE" {
+		t.Fatalf("Expected CZE for the plural demonym, got %v, %v", r, err)
+	}
+	if p, _ := idx.Plural("CZE"); p != "Czechs" {
+		t.Fatalf("Expected Czechs, got %s", p)
+	}
+}
@@ -0,0 +1,147 @@
+package countries
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// genericCcTLDs are the country code top level domains commonly registered
+// regardless of the country, such as .io for tech companies or .tv for media.
+var genericCcTLDs = map[string]bool{
+	".ac": true, ".ad": true, ".ai": true, ".am": true, ".as": true, ".cc": true,
+	".co": true, ".fm": true, ".gg": true, ".io": true, ".la": true, ".ly": true,
+	".me": true, ".nu": true, ".sh": true, ".tk": true, ".to": true, ".tv": true,
+	".vc": true, ".ws": true,
+}
+
+// secondLevelLabels are the labels registries use below a ccTLD, as in .co.uk or .com.br.
+var secondLevelLabels = map[string]bool{
+	"ac": true, "co": true, "com": true, "edu": true, "gob": true, "go": true,
+	"gov": true, "ltd": true, "me": true, "mil": true, "ne": true, "net": true,
+	"nic": true, "or": true, "org": true, "plc": true, "sch": true,
+}
+
+// DomainMatch is the country of a hostname or email address.
+// Suffix is the registry suffix of the domain, such as .co.uk, and TLD its
+// country code top level domain, such as .uk.
+type DomainMatch struct {
+	Suffix  string
+	TLD     string
+	Country Country
+	Generic bool
+}
+
+// DomainResolver maps hostnames and email addresses to countries using their TopLevelDomain.
+type DomainResolver struct {
+	tlds      map[string]Country
+	countries map[string]Country
+}
+
+// NewDomainResolver returns a new DomainResolver built from the TopLevelDomain of the given countries.
+func NewDomainResolver(countries []Country) *DomainResolver {
+	r := &DomainResolver{
+		tlds:      map[string]Country{},
+		countries: make(map[string]Country, len(countries)),
+	}
+	for _, c := range countries {
+		r.countries[strings.ToUpper(c.Alpha3Code)] = c
+		for _, tld := range c.TopLevelDomain {
+			tld = normalizeTLD(tld)
+			if _, taken := r.tlds[tld]; tld != "" && !taken {
+				r.tlds[tld] = c
+			}
+		}
+	}
+
+	return r
+}
+
+// Resolve returns the country of a hostname, URL host or email address.
+// Returns an error when its top level domain is not a known country code.
+func (r *DomainResolver) Resolve(address string) (DomainMatch, error) {
+	suffix, tld, err := EffectiveTLD(address)
+	if err != nil {
+		return DomainMatch{}, err
+	}
+	country, ok := r.tlds[tld]
+	if !ok {
+		return DomainMatch{}, fmt.Errorf("No country for top level domain %s", tld)
+	}
+
+	return DomainMatch{Suffix: suffix, TLD: tld, Country: country, Generic: IsGenericTLD(tld)}, nil
+}
+
+// TLDs returns the top level domains of the country with the given alpha-3 code, sorted.
+func (r *DomainResolver) TLDs(alpha3 string) []string {
+	country, ok := r.countries[strings.ToUpper(alpha3)]
+	if !ok {
+		return nil
+	}
+
+	var tlds []string
+	for _, tld := range country.TopLevelDomain {
+		if tld = normalizeTLD(tld); tld != "" {
+			tlds = appendMissing(tlds, tld)
+		}
+	}
+	sort.Strings(tlds)
+
+	return tlds
+}
+
+// IsGenericTLD returns true for country code top level domains commonly used
+// as generic ones, such as .io, .tv or .co.
+func IsGenericTLD(tld string) bool {
+	return genericCcTLDs[normalizeTLD(tld)]
+}
+
+// EffectiveTLD returns the registry suffix and the top level domain of a
+// hostname, URL host or email address, e.g. .co.uk and .uk for mail@bbc.co.uk.
+func EffectiveTLD(address string) (string, string, error) {
+	host := strings.ToLower(strings.TrimSpace(address))
+	if i := strings.Index(host, "://"); i >= 0 {
+		host = host[i+3:]
+	}
+	if i := strings.IndexAny(host, "/?#"); i >= 0 {
+		host = host[:i]
+	}
+	// Drops the userinfo of a URL, or the local part of an email address.
+	if i := strings.LastIndex(host, "@"); i >= 0 {
+		host = host[i+1:]
+	}
+	if i := strings.LastIndex(host, ":"); i >= 0 {
+		host = host[:i]
+	}
+	host = strings.TrimSuffix(host, ".")
+
+	labels := strings.Split(host, ".")
+	if len(labels) < 2 {
+		return "", "", fmt.Errorf("Invalid domain %q", address)
+	}
+	for _, l := range labels {
+		if l == "" {
+			return "", "", fmt.Errorf("Invalid domain %q", address)
+		}
+	}
+
+	tld := "." + labels[len(labels)-1]
+	suffix := tld
+	if second := labels[len(labels)-2]; len(labels) > 2 && secondLevelLabels[second] {
+		suffix = "." + second + tld
+	}
+
+	return suffix, tld, nil
+}
+
+func normalizeTLD(tld string) string {
+	tld = strings.ToLower(strings.TrimSpace(tld))
+	if tld == "" {
+		return ""
+	}
+	if !strings.HasPrefix(tld, ".") {
+		tld = "." + tld
+	}
+
+	return tld
+}
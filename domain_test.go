@@ -0,0 +1,73 @@
+package countries_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/georgesafta/countries"
+)
+
+func TestDomainResolverResolve(t *testing.T) {
+	resolver := countries.NewDomainResolver(loadDataset(t))
+
+	expected := []struct {
+		address, suffix, tld, code string
+		generic                    bool
+	}{
+		{"www.bbc.co.uk", ".co.uk", ".uk", "GBR", false},
+		{"editor@lemonde.fr", ".fr", ".fr", "FRA", false},
+		{"https://www.globo.com.br:443/news?id=1", ".com.br", ".br", "BRA", false},
+		{"https://example.fr/?contact=a@b.de", ".fr", ".fr", "FRA", false},
+		{"ftp://user:p@ss@files.example.es:21/a@b.de", ".es", ".es", "ESP", false},
+		{"Example.DE.", ".de", ".de", "DEU", false},
+		{"startup.io.co", ".co", ".co", "COL", true},
+		{"news.tv", ".tv", ".tv", "TUV", true},
+	}
+	for _, e := range expected {
+		match, err := resolver.Resolve(e.address)
+		if err != nil {
+			t.Fatalf("Unexpected error for %s: %v", e.address, err)
+		}
+		if match.Suffix != e.suffix || match.TLD != e.tld || match.Country.Alpha3Code != e.code || match.Generic != e.generic {
+			t.Fatalf("Unexpected match for %s: %v %v %v %v", e.address, match.Suffix, match.TLD, match.Country.Alpha3Code, match.Generic)
+		}
+	}
+}
+
+func TestDomainResolverResolveErrors(t *testing.T) {
+	resolver := countries.NewDomainResolver(loadDataset(t))
+
+	expected := map[string]string{
+		"example.com": "No country for top level domain .com",
+		"localhost":   `Invalid domain "localhost"`,
+		"bad..uk":     `Invalid domain "bad..uk"`,
+	}
+	for address, message := range expected {
+		_, err := resolver.Resolve(address)
+		if err == nil || err.Error() != message {
+			t.Fatalf("Expected error %s, got %v", message, err)
+		}
+	}
+}
+
+func TestDomainResolverTLDs(t *testing.T) {
+	resolver := countries.NewDomainResolver(loadDataset(t))
+
+	if got := resolver.TLDs("gbr"); !reflect.DeepEqual([]string{".uk"}, got) {
+		t.Fatalf("Expected .uk, got %v", got)
+	}
+	if resolver.TLDs("XXX") != nil {
+		t.Fatal("Expected no TLDs for unknown country")
+	}
+}
+
+func TestIsGenericTLD(t *testing.T) {
+	for _, tld := range []string{".io", "tv", ".CO"} {
+		if !countries.IsGenericTLD(tld) {
+			t.Fatalf("Expected %s to be generic", tld)
+		}
+	}
+	if countries.IsGenericTLD(".fr") {
+		t.Fatal("Expected .fr not to be generic")
+	}
+}
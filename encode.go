@@ -0,0 +1,579 @@
+package countries
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+)
+
+// Format is an output format of the Encoder.
+type Format string
+
+// Formats supported by the Encoder.
+const (
+	FormatCSV    Format = "csv"
+	FormatTSV    Format = "tsv"
+	FormatNDJSON Format = "ndjson"
+	FormatYAML   Format = "yaml"
+	FormatTable  Format = "table"
+)
+
+// Separators of flattened values. Lists are joined with ; the lists nested in
+// list items, such as the otherAcronyms of a regional bloc, with | and map
+// entries are written as key=value. Separators and backslashes within values
+// are escaped with a backslash. A list holding a single empty value is written
+// as \_, or \. when nested, as the empty string stands for an empty list.
+const (
+	listSeparator = ';'
+	itemSeparator = '|'
+	pairSeparator = '='
+	escapeChar    = '\\'
+)
+
+// singleEmptyValues holds the marker of a list with a single empty value, by
+// separator. Nested lists have their own, so a list item holding one empty
+// value is not read as an empty item.
+var singleEmptyValues = map[rune]string{listSeparator: `\_`, itemSeparator: `\.`}
+
+// flat reports whether the format writes one flattened value per column.
+func (f Format) flat() bool {
+	return f == FormatCSV || f == FormatTSV || f == FormatTable
+}
+
+// ParseFormat returns the format with the given name, ignoring case.
+func ParseFormat(s string) (Format, error) {
+	f := Format(strings.ToLower(strings.TrimSpace(s)))
+	switch f {
+	case FormatCSV, FormatTSV, FormatNDJSON, FormatYAML, FormatTable:
+		return f, nil
+	}
+
+	return "", fmt.Errorf("Unknown format %q", s)
+}
+
+// Encoder writes countries in a tabular or structured format.
+//
+// Columns use the field names of the fields filter, e.g. name or latlng.
+// In flat formats, lists are joined with ; and lists of objects are written
+// with their first field, e.g. the currency codes for currencies. Another
+// field is selected with a dotted column, e.g. currencies.name, and a single
+// translation with translations.<lang>. Structured formats only accept
+// top level columns and keep the nested values.
+type Encoder struct {
+	w       io.Writer
+	format  Format
+	columns []column
+}
+
+// column is a flattened value of a country.
+type column struct {
+	name  string
+	field structField
+	sub   string
+}
+
+// structField is a field of a struct, named after its json tag.
+type structField struct {
+	name      string
+	index     int
+	omitEmpty bool
+}
+
+var countryFields = jsonFields(reflect.TypeOf(Country{}))
+
+// NewEncoder returns a new Encoder writing to w.
+// With no columns, flat formats write DefaultColumns and structured formats whole countries.
+func NewEncoder(w io.Writer, format Format, columns ...string) (*Encoder, error) {
+	if _, err := ParseFormat(string(format)); err != nil {
+		return nil, err
+	}
+	if len(columns) == 0 && format.flat() {
+		columns = DefaultColumns()
+	}
+
+	e := &Encoder{w: w, format: format}
+	for _, name := range columns {
+		col, err := parseColumn(name)
+		if err != nil {
+			return nil, err
+		}
+		if col.sub != "" && !format.flat() {
+			return nil, fmt.Errorf("Column %q is not supported by the %s format", name, format)
+		}
+		e.columns = append(e.columns, col)
+	}
+
+	return e, nil
+}
+
+// DefaultColumns returns the columns holding every value of a country,
+// with one column per field of currencies, languages and regional blocs.
+func DefaultColumns() []string {
+	var columns []string
+	for _, f := range countryFields {
+		t := reflect.TypeOf(Country{}).Field(f.index).Type
+		if t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Struct {
+			for _, sub := range jsonFields(t.Elem()) {
+				columns = append(columns, f.name+"."+sub.name)
+			}
+			continue
+		}
+		columns = append(columns, f.name)
+	}
+
+	return columns
+}
+
+// Encode writes the countries.
+func (e *Encoder) Encode(countries []Country) error {
+	switch e.format {
+	case FormatCSV:
+		return e.encodeCSV(countries, ',')
+	case FormatTSV:
+		return e.encodeCSV(countries, '\t')
+	case FormatTable:
+		return e.encodeTable(countries)
+	case FormatNDJSON:
+		return e.encodeNDJSON(countries)
+	default:
+		return e.encodeYAML(countries)
+	}
+}
+
+func (e *Encoder) encodeCSV(countries []Country, comma rune) error {
+	w := csv.NewWriter(e.w)
+	w.Comma = comma
+	if err := w.Write(e.header()); err != nil {
+		return err
+	}
+	for _, c := range countries {
+		if err := w.Write(e.row(c)); err != nil {
+			return err
+		}
+	}
+	w.Flush()
+
+	return w.Error()
+}
+
+func (e *Encoder) encodeTable(countries []Country) error {
+	buf := bytes.Buffer{}
+	w := tabwriter.NewWriter(&buf, 0, 0, 2, ' ', 0)
+	cleaner := strings.NewReplacer("\t", " ", "\n", " ", "\r", " ")
+	writeRow := func(values []string) error {
+		for i, v := range values {
+			values[i] = cleaner.Replace(v)
+		}
+		_, err := fmt.Fprintln(w, strings.Join(values, "\t"))
+		return err
+	}
+
+	if err := writeRow(e.header()); err != nil {
+		return err
+	}
+	for _, c := range countries {
+		if err := writeRow(e.row(c)); err != nil {
+			return err
+		}
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	// Drop the padding tabwriter leaves after the last value of short rows.
+	out := bytes.Buffer{}
+	for _, line := range strings.SplitAfter(buf.String(), "\n") {
+		out.WriteString(strings.TrimRight(strings.TrimSuffix(line, "\n"), " "))
+		if strings.HasSuffix(line, "\n") {
+			out.WriteByte('\n')
+		}
+	}
+	_, err := e.w.Write(out.Bytes())
+
+	return err
+}
+
+func (e *Encoder) encodeNDJSON(countries []Country) error {
+	for _, c := range countries {
+		buf := bytes.Buffer{}
+		if e.columns == nil {
+			data, err := json.Marshal(c)
+			if err != nil {
+				return err
+			}
+			buf.Write(data)
+		} else {
+			buf.WriteByte('{')
+			v := reflect.ValueOf(c)
+			for i, col := range e.columns {
+				if i > 0 {
+					buf.WriteByte(',')
+				}
+				key, _ := json.Marshal(col.name)
+				value, err := json.Marshal(v.Field(col.field.index).Interface())
+				if err != nil {
+					return err
+				}
+				buf.Write(key)
+				buf.WriteByte(':')
+				buf.Write(value)
+			}
+			buf.WriteByte('}')
+		}
+		buf.WriteByte('\n')
+		if _, err := e.w.Write(buf.Bytes()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (e *Encoder) encodeYAML(countries []Country) error {
+	buf := bytes.Buffer{}
+	if len(countries) == 0 {
+		buf.WriteString("[]\n")
+	}
+	for _, c := range countries {
+		v := reflect.ValueOf(c)
+		var fields []yamlField
+		if e.columns == nil {
+			fields = structYAMLFields(v)
+		} else {
+			for _, col := range e.columns {
+				fields = append(fields, yamlField{col.name, v.Field(col.field.index)})
+			}
+		}
+		if len(fields) == 0 {
+			buf.WriteString("- {}\n")
+			continue
+		}
+		buf.WriteString("- ")
+		writeYAMLMapping(&buf, fields, 2, true)
+	}
+	_, err := e.w.Write(buf.Bytes())
+
+	return err
+}
+
+func (e *Encoder) header() []string {
+	header := make([]string, len(e.columns))
+	for i, col := range e.columns {
+		header[i] = col.name
+	}
+
+	return header
+}
+
+func (e *Encoder) row(c Country) []string {
+	v := reflect.ValueOf(c)
+	row := make([]string, len(e.columns))
+	for i, col := range e.columns {
+		row[i] = col.value(v)
+	}
+
+	return row
+}
+
+// parseColumn validates a column name, e.g. capital, currencies.name or translations.de.
+func parseColumn(name string) (column, error) {
+	top, sub := name, ""
+	if i := strings.Index(name, "."); i >= 0 {
+		top, sub = name[:i], name[i+1:]
+	}
+	for _, f := range countryFields {
+		if f.name != top {
+			continue
+		}
+		col := column{name: name, field: f, sub: sub}
+		if sub == "" {
+			return col, nil
+		}
+		t := reflect.TypeOf(Country{}).Field(f.index).Type
+		switch {
+		case t.Kind() == reflect.Map:
+			return col, nil
+		case t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Struct:
+			if _, ok := fieldByName(t.Elem(), sub); ok {
+				return col, nil
+			}
+		}
+		break
+	}
+
+	return column{}, fmt.Errorf("Unknown column %q", name)
+}
+
+// value returns the flattened value of the column.
+func (col column) value(country reflect.Value) string {
+	v := country.Field(col.field.index)
+	if col.sub == "" {
+		return flatValue(v)
+	}
+	if v.Kind() == reflect.Map {
+		if item := v.MapIndex(reflect.ValueOf(col.sub)); item.IsValid() {
+			return item.String()
+		}
+		return ""
+	}
+
+	sub, _ := fieldByName(v.Type().Elem(), col.sub)
+	values := make([]string, v.Len())
+	for i := range values {
+		values[i] = flatItem(v.Index(i).Field(sub.index))
+	}
+
+	return joinValues(values, listSeparator)
+}
+
+// flatValue flattens a top level value of a country.
+func flatValue(v reflect.Value) string {
+	switch v.Kind() {
+	case reflect.Slice:
+		values := make([]string, v.Len())
+		for i := range values {
+			item := v.Index(i)
+			if item.Kind() == reflect.Struct {
+				item = item.Field(0)
+			}
+			values[i] = escapeValue(scalarValue(item))
+		}
+		return joinValues(values, listSeparator)
+	case reflect.Map:
+		keys := sortedMapKeys(v)
+		values := make([]string, len(keys))
+		for i, k := range keys {
+			values[i] = escapeValue(k) + string(pairSeparator) + escapeValue(v.MapIndex(reflect.ValueOf(k)).String())
+		}
+		return joinValues(values, listSeparator)
+	default:
+		return scalarValue(v)
+	}
+}
+
+// flatItem flattens a field of a list item, escaping it for the list.
+func flatItem(v reflect.Value) string {
+	if v.Kind() != reflect.Slice {
+		return escapeValue(scalarValue(v))
+	}
+	values := make([]string, v.Len())
+	for i := range values {
+		values[i] = escapeValue(scalarValue(v.Index(i)))
+	}
+
+	return joinValues(values, itemSeparator)
+}
+
+func scalarValue(v reflect.Value) string {
+	switch v.Kind() {
+	case reflect.String:
+		return v.String()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10)
+	case reflect.Float32:
+		return strconv.FormatFloat(v.Float(), 'f', -1, 32)
+	case reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'f', -1, 64)
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool())
+	}
+
+	return fmt.Sprint(v.Interface())
+}
+
+func joinValues(values []string, sep rune) string {
+	if len(values) == 1 && values[0] == "" {
+		return singleEmptyValues[sep]
+	}
+
+	return strings.Join(values, string(sep))
+}
+
+func escapeValue(s string) string {
+	if !strings.ContainsAny(s, `\;|=`) {
+		return s
+	}
+	sb := strings.Builder{}
+	for _, r := range s {
+		if r == escapeChar || r == listSeparator || r == itemSeparator || r == pairSeparator {
+			sb.WriteRune(escapeChar)
+		}
+		sb.WriteRune(r)
+	}
+
+	return sb.String()
+}
+
+func sortedMapKeys(v reflect.Value) []string {
+	keys := make([]string, 0, v.Len())
+	for _, k := range v.MapKeys() {
+		keys = append(keys, k.String())
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
+// jsonFields returns the fields of a struct named after their json tag.
+func jsonFields(t reflect.Type) []structField {
+	var fields []structField
+	for i := 0; i < t.NumField(); i++ {
+		tag := t.Field(i).Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		parts := strings.Split(tag, ",")
+		f := structField{name: parts[0], index: i}
+		if f.name == "" {
+			f.name = t.Field(i).Name
+		}
+		for _, opt := range parts[1:] {
+			if opt == "omitempty" {
+				f.omitEmpty = true
+			}
+		}
+		fields = append(fields, f)
+	}
+
+	return fields
+}
+
+func fieldByName(t reflect.Type, name string) (structField, bool) {
+	for _, f := range jsonFields(t) {
+		if f.name == name {
+			return f, true
+		}
+	}
+
+	return structField{}, false
+}
+
+// yamlField is a key of a YAML mapping.
+type yamlField struct {
+	key   string
+	value reflect.Value
+}
+
+// structYAMLFields returns the fields of a struct, leaving out the empty omitempty ones as JSON does.
+func structYAMLFields(v reflect.Value) []yamlField {
+	var fields []yamlField
+	for _, f := range jsonFields(v.Type()) {
+		value := v.Field(f.index)
+		if f.omitEmpty && isEmptyValue(value) {
+			continue
+		}
+		fields = append(fields, yamlField{f.name, value})
+	}
+
+	return fields
+}
+
+func isEmptyValue(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Slice, reflect.Map, reflect.String:
+		return v.Len() == 0
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int() == 0
+	case reflect.Float32, reflect.Float64:
+		return v.Float() == 0
+	case reflect.Bool:
+		return !v.Bool()
+	}
+
+	return false
+}
+
+// writeYAMLMapping writes the fields at the given indentation.
+// When inline is true, the first field continues the current line, after a list dash.
+func writeYAMLMapping(buf *bytes.Buffer, fields []yamlField, indent int, inline bool) {
+	for i, f := range fields {
+		if i > 0 || !inline {
+			buf.WriteString(strings.Repeat(" ", indent))
+		}
+		buf.WriteString(yamlScalar(f.key))
+		buf.WriteByte(':')
+		writeYAMLNode(buf, f.value, indent)
+	}
+}
+
+// writeYAMLNode writes the value of a mapping key, starting on the key line.
+func writeYAMLNode(buf *bytes.Buffer, v reflect.Value, indent int) {
+	switch v.Kind() {
+	case reflect.Slice:
+		if v.Len() == 0 {
+			buf.WriteString(" []\n")
+			return
+		}
+		buf.WriteByte('\n')
+		for i := 0; i < v.Len(); i++ {
+			buf.WriteString(strings.Repeat(" ", indent+2))
+			buf.WriteString("- ")
+			item := v.Index(i)
+			if item.Kind() != reflect.Struct {
+				buf.WriteString(yamlValue(item))
+				buf.WriteByte('\n')
+				continue
+			}
+			fields := structYAMLFields(item)
+			if len(fields) == 0 {
+				buf.WriteString("{}\n")
+				continue
+			}
+			writeYAMLMapping(buf, fields, indent+4, true)
+		}
+	case reflect.Map:
+		if v.Len() == 0 {
+			buf.WriteString(" {}\n")
+			return
+		}
+		buf.WriteByte('\n')
+		var fields []yamlField
+		for _, k := range sortedMapKeys(v) {
+			fields = append(fields, yamlField{k, v.MapIndex(reflect.ValueOf(k))})
+		}
+		writeYAMLMapping(buf, fields, indent+2, false)
+	default:
+		buf.WriteByte(' ')
+		buf.WriteString(yamlValue(v))
+		buf.WriteByte('\n')
+	}
+}
+
+func yamlValue(v reflect.Value) string {
+	if v.Kind() == reflect.String {
+		return yamlScalar(v.String())
+	}
+
+	return scalarValue(v)
+}
+
+// yamlScalar returns the string as a plain YAML scalar, or double quoted when
+// it would otherwise be read as another type or break the syntax.
+func yamlScalar(s string) string {
+	if s == "" || strings.TrimSpace(s) != s || strings.ContainsAny(s, "\"'\\\n\r\t#") ||
+		strings.Contains(s, ": ") || strings.HasSuffix(s, ":") ||
+		strings.ContainsAny(s[:1], "-?:,[]{}&*!|>%@`") {
+		return strconv.Quote(s)
+	}
+	switch strings.ToLower(s) {
+	case "true", "false", "yes", "no", "y", "n", "on", "off", "null", "~":
+		return strconv.Quote(s)
+	}
+	if _, err := strconv.ParseFloat(s, 64); err == nil {
+		return strconv.Quote(s)
+	}
+	for _, r := range s {
+		if r < ' ' || r == 0x7f {
+			return strconv.Quote(s)
+		}
+	}
+
+	return s
+}
@@ -0,0 +1,168 @@
+package countries_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/georgesafta/countries"
+)
+
+const fullDataMockPath = "mock/full_data.json"
+
+var encodedCountry = countries.Country{
+	Name:              "Côte d'Ivoire",
+	Alpha3Code:        "CIV",
+	Alpha2Code:        "CI",
+	CallingCodes:      []string{"225"},
+	Population:        22671331,
+	LatitudeLongitude: []float32{8, -5},
+	Currencies:        []countries.Currency{{Code: "XOF", Name: "West African CFA franc", Symbol: "Fr"}},
+	Translations:      map[string]string{"es": "Costa de Marfil", "de": "Elfenbeinküste"},
+	RegionalBlocs: []countries.RegionalBloc{
+		{Acronym: "AU", Name: "African Union", OtherAcronyms: []string{}, OtherNames: []string{"الاتحاد الأفريقي", "Union africaine"}},
+		{Acronym: "X;Y", Name: "A|B", OtherAcronyms: []string{"C=D"}},
+	},
+}
+
+func loadFullData(t *testing.T) []countries.Country {
+	data, err := ioutil.ReadFile(fullDataMockPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var c []countries.Country
+	if err := json.Unmarshal(data, &c); err != nil {
+		t.Fatal(err)
+	}
+
+	return c
+}
+
+func encode(t *testing.T, format countries.Format, data []countries.Country, columns ...string) string {
+	buf := bytes.Buffer{}
+	e, err := countries.NewEncoder(&buf, format, columns...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := e.Encode(data); err != nil {
+		t.Fatal(err)
+	}
+
+	return buf.String()
+}
+
+func TestEncodeCSV(t *testing.T) {
+	out := encode(t, countries.FormatCSV, []countries.Country{encodedCountry},
+		"name", "latlng", "currencies", "currencies.name", "translations", "translations.de", "regionalBlocs.acronym", "regionalBlocs.otherAcronyms", "regionalBlocs.otherNames")
+	expected := "name,latlng,currencies,currencies.name,translations,translations.de,regionalBlocs.acronym,regionalBlocs.otherAcronyms,regionalBlocs.otherNames\n" +
+		"Côte d'Ivoire,8;-5,XOF,West African CFA franc,de=Elfenbeinküste;es=Costa de Marfil,Elfenbeinküste,AU;X\\;Y,;C\\=D,الاتحاد الأفريقي|Union africaine;\n"
+	if out != expected {
+		t.Fatalf("Expected:\n%s\ngot:\n%s", expected, out)
+	}
+
+	out = encode(t, countries.FormatTSV, []countries.Country{encodedCountry}, "alpha3Code", "population", "regionalBlocs.name")
+	if out != "alpha3Code\tpopulation\tregionalBlocs.name\nCIV\t22671331\tAfrican Union;A\\|B\n" {
+		t.Fatalf("Unexpected TSV %q", out)
+	}
+}
+
+func TestEncodeDefaultColumns(t *testing.T) {
+	columns := countries.DefaultColumns()
+	if len(columns) != 32 || columns[0] != "name" || columns[len(columns)-1] != "cioc" {
+		t.Fatalf("Unexpected default columns %v", columns)
+	}
+
+	out := encode(t, countries.FormatCSV, loadFullData(t))
+	lines := strings.Split(strings.TrimSpace(out), "\n")
+	if len(lines) != 2 || lines[0] != strings.Join(columns, ",") {
+		t.Fatalf("Unexpected CSV header %q", lines[0])
+	}
+	if !strings.Contains(lines[1], ",PA;USAN,Pacific Alliance;Union of South American Nations,;UNASUR|UNASUL|UZAN,") {
+		t.Fatalf("Expected flattened blocs in %q", lines[1])
+	}
+}
+
+func TestEncodeTable(t *testing.T) {
+	data := []countries.Country{{Name: "Peru", Alpha3Code: "PER"}, {Name: "Colombia", Alpha3Code: "COL", Capital: "Bogotá\tD.C."}}
+	out := encode(t, countries.FormatTable, data, "name", "alpha3Code", "capital")
+	expected := "name      alpha3Code  capital\n" +
+		"Peru      PER\n" +
+		"Colombia  COL         Bogotá D.C.\n"
+	if out != expected {
+		t.Fatalf("Expected:\n%s\ngot:\n%s", expected, out)
+	}
+}
+
+func TestEncodeNDJSON(t *testing.T) {
+	data := loadFullData(t)
+	out := encode(t, countries.FormatNDJSON, append(data, data...))
+	lines := strings.Split(strings.TrimSuffix(out, "\n"), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("Expected 2 lines, got %d", len(lines))
+	}
+	var c countries.Country
+	if err := json.Unmarshal([]byte(lines[0]), &c); err != nil || c.Alpha3Code != "COL" || len(c.RegionalBlocs) != 2 {
+		t.Fatalf("Unexpected line %s, %v", lines[0], err)
+	}
+
+	out = encode(t, countries.FormatNDJSON, data, "alpha3Code", "currencies", "gini")
+	expected := `{"alpha3Code":"COL","currencies":[{"code":"COP","name":"Colombian peso","symbol":"$"}],"gini":55.9}` + "\n"
+	if out != expected {
+		t.Fatalf("Expected %s, got %s", expected, out)
+	}
+}
+
+func TestEncodeYAML(t *testing.T) {
+	data := []countries.Country{
+		{Name: "Norway", Alpha2Code: "NO", NumericCode: "578", LatitudeLongitude: []float32{62, 10}, Currencies: []countries.Currency{{Code: "NOK", Name: "Norwegian krone", Symbol: "kr"}}},
+		{Name: "Côte d'Ivoire: \"CI\"", Translations: map[string]string{"fr": "Côte d'Ivoire"}},
+	}
+	out := encode(t, countries.FormatYAML, data)
+	expected := `- name: Norway
+  alpha2Code: "NO"
+  population: 0
+  latlng:
+    - 62
+    - 10
+  numericCode: "578"
+  currencies:
+    - code: NOK
+      name: Norwegian krone
+      symbol: kr
+- name: "Côte d'Ivoire: \"CI\""
+  alpha2Code: ""
+  population: 0
+  translations:
+    fr: "Côte d'Ivoire"
+`
+	if out != expected {
+		t.Fatalf("Expected:\n%s\ngot:\n%s", expected, out)
+	}
+
+	out = encode(t, countries.FormatYAML, data[:1], "name", "borders")
+	if out != "- name: Norway\n  borders: []\n" {
+		t.Fatalf("Unexpected projected YAML %q", out)
+	}
+	if out := encode(t, countries.FormatYAML, nil); out != "[]\n" {
+		t.Fatalf("Unexpected empty YAML %q", out)
+	}
+}
+
+func TestEncoderErrors(t *testing.T) {
+	if _, err := countries.NewEncoder(ioutil.Discard, "xml"); err == nil || err.Error() != `Unknown format "xml"` {
+		t.Fatalf("Expected unknown format error, got %v", err)
+	}
+	for _, column := range []string{"nope", "name.first", "currencies.rate", "latlng.0"} {
+		if _, err := countries.NewEncoder(ioutil.Discard, countries.FormatCSV, column); err == nil || err.Error() != `Unknown column "`+column+`"` {
+			t.Fatalf("Expected unknown column error for %s, got %v", column, err)
+		}
+	}
+	if _, err := countries.NewEncoder(ioutil.Discard, countries.FormatYAML, "currencies.code"); err == nil {
+		t.Fatal("Expected nested columns to be rejected by structured formats")
+	}
+	if f, err := countries.ParseFormat(" NDJSON "); err != nil || f != countries.FormatNDJSON {
+		t.Fatalf("Expected ndjson, got %s, %v", f, err)
+	}
+}
@@ -0,0 +1,13 @@
+package countries
+
+// Waiters returns the number of callers sharing the call in progress to the
+// endpoint, so tests can wait for every caller to join it.
+func (c *HTTPClient) Waiters(endpoint string) int {
+	c.flights.mu.Lock()
+	defer c.flights.mu.Unlock()
+	if f, ok := c.flights.flights[endpoint]; ok {
+		return f.waiters
+	}
+
+	return 0
+}
@@ -0,0 +1,164 @@
+package countries
+
+import (
+	"bytes"
+	"fmt"
+	"image/png"
+	"io"
+	"io/ioutil"
+	"log"
+	"mime"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// DefaultMaxFlagSize is the largest flag downloaded by default, in bytes.
+const DefaultMaxFlagSize = 1 << 20
+
+const svgContentType = "image/svg+xml"
+
+// FlagService downloads country flags and caches them on disk, keyed by alpha-3 code.
+// Cached flags are served without calling the API, so they remain available offline.
+type FlagService struct {
+	Client  *http.Client
+	MaxSize int64
+	dir     string
+}
+
+// NewFlagService returns a new FlagService caching flags in dir.
+// Flags are downloaded with the http.Client of the given HTTPClient.
+func NewFlagService(client *HTTPClient, dir string) *FlagService {
+	return &FlagService{
+		Client:  client.Client,
+		MaxSize: DefaultMaxFlagSize,
+		dir:     dir,
+	}
+}
+
+// SVG returns the SVG flag of the country, from the cache when present.
+func (s *FlagService) SVG(country Country) ([]byte, error) {
+	path, err := s.path(country)
+	if err != nil {
+		return nil, err
+	}
+	if data, err := ioutil.ReadFile(path); err == nil {
+		return data, nil
+	}
+
+	return s.Refresh(country)
+}
+
+// Refresh downloads the SVG flag of the country and replaces the cached one.
+// When the download fails, the cached flag is returned along with the error.
+func (s *FlagService) Refresh(country Country) ([]byte, error) {
+	path, err := s.path(country)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := s.download(country.FlagURL)
+	if err != nil {
+		cached, _ := ioutil.ReadFile(path)
+		return cached, err
+	}
+	if err := s.store(path, data); err != nil {
+		log.Println("Error caching the flag", err)
+		return data, err
+	}
+
+	return data, nil
+}
+
+// PNG returns the flag of the country rasterized at the given size.
+// When one of the dimensions is zero it is derived from the flag aspect ratio.
+// Only filled shapes are rendered, strokes, gradients and text are left out.
+// Images over 16 megapixels are rejected, whether requested or declared by the flag.
+func (s *FlagService) PNG(country Country, width, height int) ([]byte, error) {
+	data, err := s.SVG(country)
+	if err != nil {
+		return nil, err
+	}
+
+	img, err := rasterizeSVG(data, width, height)
+	if err != nil {
+		return nil, err
+	}
+	buf := bytes.Buffer{}
+	if err := png.Encode(&buf, img); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func (s *FlagService) path(country Country) (string, error) {
+	code := strings.ToLower(strings.TrimSpace(country.Alpha3Code))
+	if len(code) != 3 || strings.IndexFunc(code, func(r rune) bool { return r < 'a' || r > 'z' }) >= 0 {
+		return "", fmt.Errorf("Invalid alpha-3 code %q", country.Alpha3Code)
+	}
+
+	return filepath.Join(s.dir, code+".svg"), nil
+}
+
+func (s *FlagService) download(url string) ([]byte, error) {
+	if url == "" {
+		return nil, fmt.Errorf("Missing flag URL")
+	}
+	res, err := s.Client.Get(url)
+	if err != nil {
+		log.Println("Error downloading the flag", err)
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		e := fmt.Errorf("Unexpected flag status code %s", res.Status)
+		log.Println("Unsucessfull call", e)
+		return nil, e
+	}
+	contentType, _, err := mime.ParseMediaType(res.Header.Get("Content-Type"))
+	if err != nil || contentType != svgContentType {
+		return nil, fmt.Errorf("Unexpected flag content type %q", res.Header.Get("Content-Type"))
+	}
+	if res.ContentLength > s.MaxSize {
+		return nil, fmt.Errorf("Flag exceeds %d bytes", s.MaxSize)
+	}
+
+	data, err := ioutil.ReadAll(io.LimitReader(res.Body, s.MaxSize+1))
+	if err != nil {
+		log.Println("Error reading the flag", err)
+		return nil, err
+	}
+	if int64(len(data)) > s.MaxSize {
+		return nil, fmt.Errorf("Flag exceeds %d bytes", s.MaxSize)
+	}
+	if !bytes.Contains(data, []byte("<svg")) {
+		return nil, fmt.Errorf("Flag is not an SVG document")
+	}
+
+	return data, nil
+}
+
+// store writes the flag through a temporary file, so readers never see a partial flag.
+func (s *FlagService) store(path string, data []byte) error {
+	if err := os.MkdirAll(s.dir, 0755); err != nil {
+		return err
+	}
+	tmp, err := ioutil.TempFile(s.dir, ".flag-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), path)
+}
@@ -0,0 +1,211 @@
+package countries_test
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/png"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/georgesafta/countries"
+)
+
+var flagMockPath = "mock/flag.svg"
+
+// flagHandler serves the mock flag, recording the path of other requests in unexpected.
+func flagHandler(contentType string, calls *int, unexpected *string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		*calls++
+		if r.URL.Path != "/data/col.svg" {
+			*unexpected = r.URL.Path
+			http.NotFound(w, r)
+			return
+		}
+		data, _ := ioutil.ReadFile(flagMockPath)
+		w.Header().Set("Content-Type", contentType)
+		w.Write(data)
+	}
+}
+
+func newFlagService(t *testing.T) (*countries.FlagService, string) {
+	dir, err := ioutil.TempDir("", "flags")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return countries.NewFlagService(countries.NewHTTPClient(countries.BaseURL), dir), dir
+}
+
+func TestFlagServiceSVG(t *testing.T) {
+	calls, unexpected := 0, ""
+	ts := httptest.NewServer(flagHandler("image/svg+xml; charset=utf-8", &calls, &unexpected))
+	service, dir := newFlagService(t)
+	defer os.RemoveAll(dir)
+
+	country := countries.Country{Alpha3Code: "COL", FlagURL: ts.URL + "/data/col.svg"}
+	data, err := service.SVG(country)
+	if unexpected != "" {
+		t.Fatalf("Unexpected flag url %s", unexpected)
+	}
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	expected, _ := ioutil.ReadFile(flagMockPath)
+	if !bytes.Equal(expected, data) {
+		t.Fatal("Unexpected flag content")
+	}
+	if cached, _ := ioutil.ReadFile(filepath.Join(dir, "col.svg")); !bytes.Equal(expected, cached) {
+		t.Fatal("Expected flag to be cached by alpha-3 code")
+	}
+
+	ts.Close()
+	data, err = service.SVG(country)
+	if err != nil || !bytes.Equal(expected, data) || calls != 1 {
+		t.Fatalf("Expected cached flag while offline, got %v after %d calls", err, calls)
+	}
+
+	data, err = service.Refresh(country)
+	if err == nil || !bytes.Equal(expected, data) {
+		t.Fatalf("Expected cached flag along with the refresh error, got %v", err)
+	}
+}
+
+func TestFlagServiceValidation(t *testing.T) {
+	calls, unexpected := 0, ""
+	ts := httptest.NewServer(flagHandler("text/html", &calls, &unexpected))
+	defer ts.Close()
+	service, dir := newFlagService(t)
+	defer os.RemoveAll(dir)
+
+	country := countries.Country{Alpha3Code: "COL", FlagURL: ts.URL + "/data/col.svg"}
+	_, err := service.SVG(country)
+	if err == nil || err.Error() != `Unexpected flag content type "text/html"` {
+		t.Fatalf("Expected content type error, got %v", err)
+	}
+
+	ts.Config.Handler = flagHandler("image/svg+xml", &calls, &unexpected)
+	service.MaxSize = 100
+	_, err = service.SVG(country)
+	if err == nil || err.Error() != "Flag exceeds 100 bytes" {
+		t.Fatalf("Expected size error, got %v", err)
+	}
+
+	_, err = service.SVG(countries.Country{Alpha3Code: "../x", FlagURL: country.FlagURL})
+	if err == nil || err.Error() != `Invalid alpha-3 code "../x"` {
+		t.Fatalf("Expected invalid code error, got %v", err)
+	}
+
+	files, _ := ioutil.ReadDir(dir)
+	if len(files) != 0 {
+		t.Fatalf("Expected nothing cached, got %d files", len(files))
+	}
+	if unexpected != "" {
+		t.Fatalf("Unexpected flag url %s", unexpected)
+	}
+}
+
+func TestFlagServicePNG(t *testing.T) {
+	calls, unexpected := 0, ""
+	ts := httptest.NewServer(flagHandler("image/svg+xml", &calls, &unexpected))
+	defer ts.Close()
+	service, dir := newFlagService(t)
+	defer os.RemoveAll(dir)
+
+	country := countries.Country{Alpha3Code: "COL", FlagURL: ts.URL + "/data/col.svg"}
+	data, err := service.PNG(country, 60, 0)
+	if unexpected != "" {
+		t.Fatalf("Unexpected flag url %s", unexpected)
+	}
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	img, err := png.Decode(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("Invalid PNG: %v", err)
+	}
+	if img.Bounds() != image.Rect(0, 0, 60, 40) {
+		t.Fatalf("Expected a 60x40 image, got %v", img.Bounds())
+	}
+
+	expected := map[image.Point][4]uint32{
+		{10, 10}: {0x00, 0x23, 0x95, 0xff},
+		{50, 30}: {0xed, 0x29, 0x39, 0xff},
+		{30, 10}: {0xff, 0xff, 0xff, 0xff},
+		{22, 22}: {0x00, 0x00, 0x00, 0xff},
+		{35, 35}: {0xff, 0xff, 0xff, 0xff},
+	}
+	for p, rgba := range expected {
+		r, g, b, a := img.At(p.X, p.Y).RGBA()
+		if [4]uint32{r >> 8, g >> 8, b >> 8, a >> 8} != rgba {
+			t.Fatalf("Unexpected color at %v: %v", p, [4]uint32{r >> 8, g >> 8, b >> 8, a >> 8})
+		}
+	}
+}
+
+func TestFlagServicePNGUseExpansion(t *testing.T) {
+	// Self references and chains of <use> elements would expand to billions of elements.
+	chain := `<rect id="c0" width="1" height="1"/>`
+	for i := 1; i <= 20; i++ {
+		chain += fmt.Sprintf(`<g id="c%d"><use href="#c%d"/><use href="#c%d"/><use href="#c%d"/></g>`, i, i-1, i-1, i-1)
+	}
+	for name, svg := range map[string]string{
+		"self":  `<g id="a"><rect width="10" height="10"/><use href="#a"/><use href="#a"/><use href="#a"/></g>`,
+		"chain": `<defs>` + chain + `</defs><use href="#c20"/>`,
+	} {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "image/svg+xml")
+			fmt.Fprintf(w, `<svg xmlns="http://www.w3.org/2000/svg" viewBox="0 0 10 10">%s</svg>`, svg)
+		}))
+		service, dir := newFlagService(t)
+
+		done := make(chan error, 1)
+		go func() {
+			_, err := service.PNG(countries.Country{Alpha3Code: "COL", FlagURL: ts.URL + "/col.svg"}, 10, 10)
+			done <- err
+		}()
+		select {
+		case err := <-done:
+			if err != nil {
+				t.Fatalf("Unexpected error for %s: %v", name, err)
+			}
+		case <-time.After(10 * time.Second):
+			t.Fatalf("Expected the %s expansion to be bounded", name)
+		}
+		ts.Close()
+		os.RemoveAll(dir)
+	}
+}
+
+func TestFlagServicePNGSize(t *testing.T) {
+	var size string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "image/svg+xml")
+		fmt.Fprintf(w, `<svg xmlns="http://www.w3.org/2000/svg" %s viewBox="0 0 3 2"><rect width="3" height="2"/></svg>`, size)
+	}))
+	defer ts.Close()
+
+	for _, c := range []struct {
+		size          string
+		width, height int
+		err           string
+	}{
+		{`width="100000000" height="100000000"`, 0, 0, "Image size 100000000x100000000 exceeds 16777216 pixels"},
+		{`width="50000" height="50000"`, 0, 0, "Image size 50000x50000 exceeds 16777216 pixels"},
+		{`width="3" height="2"`, 6000, 0, "Image size 6000x4000 exceeds 16777216 pixels"},
+		{`width="3" height="2"`, 1 << 30, 1 << 30, "Image size 1073741824x1073741824 exceeds 16777216 pixels"},
+	} {
+		size = c.size
+		service, dir := newFlagService(t)
+		_, err := service.PNG(countries.Country{Alpha3Code: "COL", FlagURL: ts.URL + "/col.svg"}, c.width, c.height)
+		os.RemoveAll(dir)
+		if err == nil || err.Error() != c.err {
+			t.Fatalf("Expected %q for %s at %dx%d, got %v", c.err, c.size, c.width, c.height, err)
+		}
+	}
+}
@@ -0,0 +1,269 @@
+package countries
+
+import (
+	"container/heap"
+	"fmt"
+	"math"
+	"sort"
+)
+
+// EarthRadius is the mean radius of the earth in kilometres.
+const EarthRadius = 6371.0088
+
+// Coordinate is a point on the earth, in decimal degrees.
+type Coordinate struct {
+	Latitude  float64
+	Longitude float64
+}
+
+// GeoResult is a country found by a geographic query, with its distance in kilometres.
+type GeoResult struct {
+	Country  Country
+	Distance float64
+}
+
+// Coordinate returns the coordinate of the country from its LatitudeLongitude.
+// Returns false when the country has no coordinate.
+func (c Country) Coordinate() (Coordinate, bool) {
+	if len(c.LatitudeLongitude) != 2 {
+		return Coordinate{}, false
+	}
+
+	return Coordinate{Latitude: float64(c.LatitudeLongitude[0]), Longitude: float64(c.LatitudeLongitude[1])}, true
+}
+
+// Distance returns the great-circle distance in kilometres between two coordinates,
+// using the haversine formula.
+func Distance(a, b Coordinate) float64 {
+	lat1, lat2 := radians(a.Latitude), radians(b.Latitude)
+	dLat := lat2 - lat1
+	dLng := radians(b.Longitude - a.Longitude)
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLng/2)*math.Sin(dLng/2)
+
+	return 2 * EarthRadius * math.Asin(math.Min(1, math.Sqrt(h)))
+}
+
+// CountryDistance returns the great-circle distance in kilometres between the
+// coordinates of two countries.
+// Returns an error when one of the countries has no coordinate.
+func CountryDistance(a, b Country) (float64, error) {
+	ca, ok := a.Coordinate()
+	if !ok {
+		return 0, fmt.Errorf("Missing coordinate for %s", a.Alpha3Code)
+	}
+	cb, ok := b.Coordinate()
+	if !ok {
+		return 0, fmt.Errorf("Missing coordinate for %s", b.Alpha3Code)
+	}
+
+	return Distance(ca, cb), nil
+}
+
+// GeoIndex is a spatial index over the coordinates of a set of countries.
+// Points are stored as unit vectors in a k-d tree, so queries are not
+// affected by the antimeridian or the poles.
+type GeoIndex struct {
+	root *geoNode
+	size int
+}
+
+type geoNode struct {
+	country     Country
+	coordinate  Coordinate
+	point       [3]float64
+	axis        int
+	left, right *geoNode
+}
+
+// NewGeoIndex returns a new GeoIndex over the given countries.
+// Countries without a coordinate are left out.
+func NewGeoIndex(countries []Country) *GeoIndex {
+	var nodes []*geoNode
+	for _, c := range countries {
+		if coordinate, ok := c.Coordinate(); ok {
+			nodes = append(nodes, &geoNode{country: c, coordinate: coordinate, point: unitVector(coordinate)})
+		}
+	}
+
+	return &GeoIndex{root: buildGeoTree(nodes, 0), size: len(nodes)}
+}
+
+func buildGeoTree(nodes []*geoNode, depth int) *geoNode {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	axis := depth % 3
+	sort.Slice(nodes, func(i, j int) bool {
+		return nodes[i].point[axis] < nodes[j].point[axis]
+	})
+	median := len(nodes) / 2
+	node := nodes[median]
+	node.axis = axis
+	node.left = buildGeoTree(nodes[:median], depth+1)
+	node.right = buildGeoTree(nodes[median+1:], depth+1)
+
+	return node
+}
+
+// Len returns the number of countries in the index.
+func (g *GeoIndex) Len() int {
+	return g.size
+}
+
+// Nearest returns the n countries closest to the point, closest first.
+func (g *GeoIndex) Nearest(point Coordinate, n int) []GeoResult {
+	if n <= 0 {
+		return nil
+	}
+
+	target := unitVector(point)
+	h := &geoHeap{}
+	var search func(node *geoNode)
+	search = func(node *geoNode) {
+		if node == nil {
+			return
+		}
+		d := chordSquared(target, node.point)
+		if h.Len() < n {
+			heap.Push(h, geoCandidate{node: node, distance: d})
+		} else if d < (*h)[0].distance {
+			(*h)[0] = geoCandidate{node: node, distance: d}
+			heap.Fix(h, 0)
+		}
+
+		diff := target[node.axis] - node.point[node.axis]
+		near, far := node.left, node.right
+		if diff > 0 {
+			near, far = far, near
+		}
+		search(near)
+		if h.Len() < n || diff*diff < (*h)[0].distance {
+			search(far)
+		}
+	}
+	search(g.root)
+
+	nodes := make([]*geoNode, h.Len())
+	for i := range nodes {
+		nodes[i] = (*h)[i].node
+	}
+
+	return geoResults(point, nodes)
+}
+
+// Within returns the countries whose coordinate lies within radius kilometres
+// of the point, closest first.
+func (g *GeoIndex) Within(point Coordinate, radius float64) []GeoResult {
+	if radius < 0 {
+		return nil
+	}
+
+	target := unitVector(point)
+	limit := 4.0
+	if radius < math.Pi*EarthRadius {
+		chord := 2 * math.Sin(radius/(2*EarthRadius))
+		limit = chord * chord
+	}
+
+	var nodes []*geoNode
+	var search func(node *geoNode)
+	search = func(node *geoNode) {
+		if node == nil {
+			return
+		}
+		if chordSquared(target, node.point) <= limit {
+			nodes = append(nodes, node)
+		}
+		diff := target[node.axis] - node.point[node.axis]
+		if diff <= 0 || diff*diff <= limit {
+			search(node.left)
+		}
+		if diff >= 0 || diff*diff <= limit {
+			search(node.right)
+		}
+	}
+	search(g.root)
+
+	return geoResults(point, nodes)
+}
+
+// InBox returns the countries whose coordinate lies inside the box going from
+// the south west corner to the north east corner, sorted by code.
+// A box whose west longitude is greater than its east longitude crosses the antimeridian.
+func (g *GeoIndex) InBox(southWest, northEast Coordinate) []Country {
+	var countries []Country
+	var walk func(node *geoNode)
+	walk = func(node *geoNode) {
+		if node == nil {
+			return
+		}
+		c := node.coordinate
+		inLat := c.Latitude >= southWest.Latitude && c.Latitude <= northEast.Latitude
+		inLng := c.Longitude >= southWest.Longitude && c.Longitude <= northEast.Longitude
+		if southWest.Longitude > northEast.Longitude {
+			inLng = c.Longitude >= southWest.Longitude || c.Longitude <= northEast.Longitude
+		}
+		if inLat && inLng {
+			countries = append(countries, node.country)
+		}
+		walk(node.left)
+		walk(node.right)
+	}
+	walk(g.root)
+	sort.Slice(countries, func(i, j int) bool {
+		return countries[i].Alpha3Code < countries[j].Alpha3Code
+	})
+
+	return countries
+}
+
+func geoResults(point Coordinate, nodes []*geoNode) []GeoResult {
+	results := make([]GeoResult, len(nodes))
+	for i, node := range nodes {
+		results[i] = GeoResult{Country: node.country, Distance: Distance(point, node.coordinate)}
+	}
+	sort.Slice(results, func(i, j int) bool {
+		if results[i].Distance != results[j].Distance {
+			return results[i].Distance < results[j].Distance
+		}
+		return results[i].Country.Alpha3Code < results[j].Country.Alpha3Code
+	})
+
+	return results
+}
+
+type geoCandidate struct {
+	node     *geoNode
+	distance float64
+}
+
+// geoHeap is a max heap of candidates on their distance.
+type geoHeap []geoCandidate
+
+func (h geoHeap) Len() int            { return len(h) }
+func (h geoHeap) Less(i, j int) bool  { return h[i].distance > h[j].distance }
+func (h geoHeap) Swap(i, j int)       { h[i], h[j] = h[j], h[i] }
+func (h *geoHeap) Push(x interface{}) { *h = append(*h, x.(geoCandidate)) }
+func (h *geoHeap) Pop() interface{} {
+	old := *h
+	x := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return x
+}
+
+func radians(degrees float64) float64 {
+	return degrees * math.Pi / 180
+}
+
+func unitVector(c Coordinate) [3]float64 {
+	lat, lng := radians(c.Latitude), radians(c.Longitude)
+
+	return [3]float64{math.Cos(lat) * math.Cos(lng), math.Cos(lat) * math.Sin(lng), math.Sin(lat)}
+}
+
+func chordSquared(a, b [3]float64) float64 {
+	dx, dy, dz := a[0]-b[0], a[1]-b[1], a[2]-b[2]
+
+	return dx*dx + dy*dy + dz*dz
+}
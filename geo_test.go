@@ -0,0 +1,131 @@
+package countries_test
+
+import (
+	"math"
+	"sort"
+	"testing"
+
+	"github.com/georgesafta/countries"
+)
+
+func TestDistance(t *testing.T) {
+	d := countries.Distance(countries.Coordinate{}, countries.Coordinate{Latitude: 0, Longitude: 90})
+	if math.Abs(d-math.Pi*countries.EarthRadius/2) > 1e-6 {
+		t.Fatalf("Unexpected quarter circumference %v", d)
+	}
+
+	d = countries.Distance(countries.Coordinate{Latitude: 0, Longitude: 179}, countries.Coordinate{Latitude: 0, Longitude: -179})
+	if math.Abs(d-222.39) > 0.01 {
+		t.Fatalf("Unexpected distance across the antimeridian %v", d)
+	}
+}
+
+func TestCountryDistance(t *testing.T) {
+	data := loadDataset(t)
+	col, pan := data[0], data[3]
+	d, err := countries.CountryDistance(col, pan)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if math.Abs(d-1043.91) > 0.01 {
+		t.Fatalf("Unexpected distance between COL and PAN %v", d)
+	}
+
+	_, err = countries.CountryDistance(col, countries.Country{Alpha3Code: "XXX"})
+	if err == nil || err.Error() != "Missing coordinate for XXX" {
+		t.Fatalf("Expected missing coordinate error, got %v", err)
+	}
+}
+
+func TestGeoIndexNearest(t *testing.T) {
+	data := append(loadDataset(t), countries.Country{Alpha3Code: "XXX"})
+	index := countries.NewGeoIndex(data)
+	if index.Len() != len(data)-1 {
+		t.Fatalf("Expected countries without coordinate to be skipped, got %d", index.Len())
+	}
+
+	points := []countries.Coordinate{{Latitude: 48.85, Longitude: 2.35}, {Latitude: -33.9, Longitude: 151.2}, {Latitude: 0, Longitude: -179.9}, {Latitude: 89, Longitude: 0}}
+	for _, p := range points {
+		expected := bruteForce(data, p)
+		for _, n := range []int{1, 5, len(expected), len(expected) + 3} {
+			got := index.Nearest(p, n)
+			want := expected
+			if n < len(want) {
+				want = want[:n]
+			}
+			if len(got) != len(want) {
+				t.Fatalf("Expected %d results, got %d", len(want), len(got))
+			}
+			for i := range want {
+				if got[i].Country.Alpha3Code != want[i].Country.Alpha3Code || math.Abs(got[i].Distance-want[i].Distance) > 1e-6 {
+					t.Fatalf("Nearest %d to %v: expected %v, got %v", n, p, want[i].Country.Alpha3Code, got[i].Country.Alpha3Code)
+				}
+			}
+		}
+	}
+
+	if got := index.Nearest(points[0], 0); len(got) != 0 {
+		t.Fatalf("Expected no results, got %v", got)
+	}
+}
+
+func TestGeoIndexWithin(t *testing.T) {
+	data := loadDataset(t)
+	index := countries.NewGeoIndex(data)
+	paris := countries.Coordinate{Latitude: 48.85, Longitude: 2.35}
+
+	for _, radius := range []float64{0, 500, 1000, 2500, 30000} {
+		var expected []string
+		for _, r := range bruteForce(data, paris) {
+			if r.Distance <= radius {
+				expected = append(expected, r.Country.Alpha3Code)
+			}
+		}
+		got := index.Within(paris, radius)
+		if len(got) != len(expected) {
+			t.Fatalf("Within %v: expected %v, got %v", radius, expected, got)
+		}
+		for i := range expected {
+			if got[i].Country.Alpha3Code != expected[i] {
+				t.Fatalf("Within %v: expected %v, got %v", radius, expected, got)
+			}
+		}
+	}
+}
+
+func TestGeoIndexInBox(t *testing.T) {
+	index := countries.NewGeoIndex(loadDataset(t))
+
+	got := index.InBox(countries.Coordinate{Latitude: 35, Longitude: -10}, countries.Coordinate{Latitude: 50, Longitude: 15})
+	expected := []string{"AUT", "CHE", "ESP", "FRA", "PRT"}
+	if len(got) != len(expected) {
+		t.Fatalf("Expected %v, got %v", expected, got)
+	}
+	for i := range expected {
+		if got[i].Alpha3Code != expected[i] {
+			t.Fatalf("Expected %v, got %v", expected, got)
+		}
+	}
+
+	got = index.InBox(countries.Coordinate{Latitude: -20, Longitude: 170}, countries.Coordinate{Latitude: 0, Longitude: -170})
+	if len(got) != 1 || got[0].Alpha3Code != "TUV" {
+		t.Fatalf("Expected box across the antimeridian to contain TUV, got %v", got)
+	}
+}
+
+func bruteForce(data []countries.Country, p countries.Coordinate) []countries.GeoResult {
+	var results []countries.GeoResult
+	for _, c := range data {
+		if coordinate, ok := c.Coordinate(); ok {
+			results = append(results, countries.GeoResult{Country: c, Distance: countries.Distance(p, coordinate)})
+		}
+	}
+	sort.Slice(results, func(i, j int) bool {
+		if results[i].Distance != results[j].Distance {
+			return results[i].Distance < results[j].Distance
+		}
+		return results[i].Country.Alpha3Code < results[j].Country.Alpha3Code
+	})
+
+	return results
+}
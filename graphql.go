@@ -0,0 +1,1343 @@
+package countries
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// maxGraphQLBody is the largest GraphQL request body accepted, in bytes.
+const maxGraphQLBody = 1 << 20
+
+// maxGraphQLNesting is the deepest nesting of selection sets, list and object
+// values and list types accepted by the parser, so that deeply nested input
+// cannot exhaust the stack.
+const maxGraphQLNesting = 64
+
+// Defaults of the query limits of a GraphQLHandler.
+const (
+	DefaultGraphQLMaxDepth  = 10
+	DefaultGraphQLMaxFields = 50000
+)
+
+// GraphQLError is an error of a GraphQL request, as written in the response.
+type GraphQLError struct {
+	Message   string            `json:"message"`
+	Locations []GraphQLLocation `json:"locations,omitempty"`
+	Path      []interface{}     `json:"path,omitempty"`
+}
+
+// GraphQLLocation is a position in a GraphQL query, starting at line 1, column 1.
+type GraphQLLocation struct {
+	Line   int `json:"line"`
+	Column int `json:"column"`
+}
+
+func (e *GraphQLError) Error() string {
+	return e.Message
+}
+
+// GraphQLResponse is the result of a GraphQL query.
+type GraphQLResponse struct {
+	Data   interface{}     `json:"data,omitempty"`
+	Errors []*GraphQLError `json:"errors,omitempty"`
+}
+
+// GraphQLHandler is an http.Handler serving GraphQL queries over a dataset.
+//
+// Queries are read from the query parameters of GET requests or from the JSON
+// body of POST requests, with their variables and operation name. Queries
+// support aliases, variables, fragments and the @skip and @include directives.
+// Mutations, subscriptions and introspection other than __typename are not
+// supported. Use GraphQLSchema for the schema.
+//
+// Relation fields such as borders nest without bound, so queries deeper than
+// MaxDepth are rejected before running, and queries stop with an error once
+// MaxFields fields have been resolved.
+//
+// The parser and executor are part of this package, rather than of a module
+// of their own as the gRPC service is, so that countries-server keeps serving
+// GraphQL from the dependency-free root module. They only cover the subset of
+// GraphQL above over the fixed schema of graphql_schema.go; a query language
+// feature outside of it belongs with a GraphQL library in a separate module.
+type GraphQLHandler struct {
+	// MaxDepth is the deepest nesting of fields in a query, counting the
+	// top-level fields as 1. Values below 1 use DefaultGraphQLMaxDepth.
+	MaxDepth int
+	// MaxFields is the number of fields a query can resolve, counting every
+	// field of every list element. Values below 1 use DefaultGraphQLMaxFields.
+	MaxFields int
+	schema    *gqlSchema
+}
+
+// NewGraphQLHandler returns a new GraphQLHandler serving the given countries.
+func NewGraphQLHandler(countries []Country) *GraphQLHandler {
+	return &GraphQLHandler{
+		MaxDepth:  DefaultGraphQLMaxDepth,
+		MaxFields: DefaultGraphQLMaxFields,
+		schema:    newCountrySchema(countries),
+	}
+}
+
+// GraphQLSchema returns the schema served by the GraphQLHandler, in the GraphQL schema language.
+func GraphQLSchema() string {
+	return newCountrySchema(nil).sdl()
+}
+
+// Execute runs a GraphQL query with its variables.
+// The operation name is only needed when the query has several operations.
+func (h *GraphQLHandler) Execute(query string, variables map[string]interface{}, operationName string) *GraphQLResponse {
+	doc, err := parseGraphQL(query)
+	if err != nil {
+		return &GraphQLResponse{Errors: []*GraphQLError{err.(*GraphQLError)}}
+	}
+
+	maxDepth, maxFields := h.MaxDepth, h.MaxFields
+	if maxDepth < 1 {
+		maxDepth = DefaultGraphQLMaxDepth
+	}
+	if maxFields < 1 {
+		maxFields = DefaultGraphQLMaxFields
+	}
+
+	return h.schema.execute(doc, variables, operationName, maxDepth, maxFields)
+}
+
+type graphQLRequest struct {
+	Query         string                 `json:"query"`
+	Variables     map[string]interface{} `json:"variables"`
+	OperationName string                 `json:"operationName"`
+}
+
+func (h *GraphQLHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	req := graphQLRequest{}
+	switch r.Method {
+	case http.MethodGet:
+		q := r.URL.Query()
+		req.Query = q.Get("query")
+		req.OperationName = q.Get("operationName")
+		if v := q.Get("variables"); v != "" {
+			if err := json.Unmarshal([]byte(v), &req.Variables); err != nil {
+				writeGraphQL(w, http.StatusBadRequest, graphQLFailure("Invalid variables: %v", err))
+				return
+			}
+		}
+	case http.MethodPost:
+		body, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, maxGraphQLBody))
+		if err != nil {
+			writeGraphQL(w, http.StatusRequestEntityTooLarge, graphQLFailure("Request body too large"))
+			return
+		}
+		if err := json.Unmarshal(body, &req); err != nil {
+			writeGraphQL(w, http.StatusBadRequest, graphQLFailure("Invalid request body: %v", err))
+			return
+		}
+	default:
+		w.Header().Set("Allow", "GET, POST")
+		writeGraphQL(w, http.StatusMethodNotAllowed, graphQLFailure("Method %s not allowed", r.Method))
+		return
+	}
+	if strings.TrimSpace(req.Query) == "" {
+		writeGraphQL(w, http.StatusBadRequest, graphQLFailure("Missing query"))
+		return
+	}
+
+	res := h.Execute(req.Query, req.Variables, req.OperationName)
+	status := http.StatusOK
+	if res.Data == nil {
+		status = http.StatusBadRequest
+	}
+	writeGraphQL(w, status, res)
+}
+
+func graphQLFailure(format string, args ...interface{}) *GraphQLResponse {
+	return &GraphQLResponse{Errors: []*GraphQLError{{Message: fmt.Sprintf(format, args...)}}}
+}
+
+func writeGraphQL(w http.ResponseWriter, status int, res *GraphQLResponse) {
+	data, _ := json.Marshal(res)
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	w.Write(data)
+}
+
+// Parsing
+
+type gqlTokenKind int
+
+const (
+	gqlEOF gqlTokenKind = iota
+	gqlPunct
+	gqlName
+	gqlInt
+	gqlFloat
+	gqlString
+)
+
+type gqlToken struct {
+	kind  gqlTokenKind
+	value string
+	pos   int
+}
+
+type gqlValueKind int
+
+const (
+	gqlVariableValue gqlValueKind = iota
+	gqlIntValue
+	gqlFloatValue
+	gqlStringValue
+	gqlBooleanValue
+	gqlNullValue
+	gqlEnumValue
+	gqlListValue
+	gqlObjectValue
+)
+
+type gqlValue struct {
+	kind   gqlValueKind
+	raw    string
+	list   []gqlValue
+	fields []gqlArgument
+}
+
+type gqlArgument struct {
+	name  string
+	value gqlValue
+}
+
+type gqlDirective struct {
+	name string
+	args []gqlArgument
+}
+
+type gqlSelectionKind int
+
+const (
+	gqlFieldSelection gqlSelectionKind = iota
+	gqlFragmentSpread
+	gqlInlineFragment
+)
+
+type gqlSelection struct {
+	kind          gqlSelectionKind
+	alias         string
+	name          string
+	args          []gqlArgument
+	directives    []gqlDirective
+	selections    []gqlSelection
+	typeCondition string
+	pos           int
+}
+
+type gqlVariable struct {
+	name string
+	typ  string
+	def  *gqlValue
+	pos  int
+}
+
+type gqlOperation struct {
+	kind       string
+	name       string
+	variables  []gqlVariable
+	selections []gqlSelection
+	pos        int
+}
+
+type gqlFragment struct {
+	typeCondition string
+	selections    []gqlSelection
+}
+
+type gqlDocument struct {
+	source     string
+	operations []*gqlOperation
+	fragments  map[string]*gqlFragment
+}
+
+type gqlParser struct {
+	source string
+	tokens []gqlToken
+	i      int
+	// depth is the current nesting of selection sets, values and types.
+	depth int
+}
+
+// parseGraphQL parses a query document.
+// Errors are *GraphQLError with the location of the syntax error.
+func parseGraphQL(source string) (*gqlDocument, error) {
+	tokens, err := lexGraphQL(source)
+	if err != nil {
+		return nil, err
+	}
+	p := &gqlParser{source: source, tokens: tokens}
+	doc := &gqlDocument{source: source, fragments: map[string]*gqlFragment{}}
+	for p.peek().kind != gqlEOF {
+		t := p.peek()
+		switch {
+		case t.kind == gqlPunct && t.value == "{":
+			selections, err := p.selectionSet()
+			if err != nil {
+				return nil, err
+			}
+			doc.operations = append(doc.operations, &gqlOperation{kind: "query", selections: selections, pos: t.pos})
+		case t.kind == gqlName && (t.value == "query" || t.value == "mutation" || t.value == "subscription"):
+			op, err := p.operation()
+			if err != nil {
+				return nil, err
+			}
+			doc.operations = append(doc.operations, op)
+		case t.kind == gqlName && t.value == "fragment":
+			p.next()
+			name, err := p.name()
+			if err != nil {
+				return nil, err
+			}
+			if err := p.keyword("on"); err != nil {
+				return nil, err
+			}
+			typeCondition, err := p.name()
+			if err != nil {
+				return nil, err
+			}
+			selections, err := p.selectionSet()
+			if err != nil {
+				return nil, err
+			}
+			if _, ok := doc.fragments[name]; ok {
+				return nil, p.errorAt(t.pos, "There can be only one fragment named %q", name)
+			}
+			doc.fragments[name] = &gqlFragment{typeCondition: typeCondition, selections: selections}
+		default:
+			return nil, p.unexpected(t)
+		}
+	}
+	if len(doc.operations) == 0 {
+		return nil, p.errorAt(len(source), "Document has no operation")
+	}
+
+	return doc, nil
+}
+
+func (p *gqlParser) peek() gqlToken {
+	return p.tokens[p.i]
+}
+
+func (p *gqlParser) next() gqlToken {
+	t := p.tokens[p.i]
+	if t.kind != gqlEOF {
+		p.i++
+	}
+	return t
+}
+
+func (p *gqlParser) isPunct(value string) bool {
+	t := p.peek()
+	return t.kind == gqlPunct && t.value == value
+}
+
+func (p *gqlParser) expect(value string) error {
+	if !p.isPunct(value) {
+		return p.errorAt(p.peek().pos, "Expected %q, found %s", value, describeToken(p.peek()))
+	}
+	p.next()
+	return nil
+}
+
+func (p *gqlParser) keyword(value string) error {
+	t := p.peek()
+	if t.kind != gqlName || t.value != value {
+		return p.errorAt(t.pos, "Expected %q, found %s", value, describeToken(t))
+	}
+	p.next()
+	return nil
+}
+
+func (p *gqlParser) name() (string, error) {
+	t := p.peek()
+	if t.kind != gqlName {
+		return "", p.errorAt(t.pos, "Expected name, found %s", describeToken(t))
+	}
+	p.next()
+	return t.value, nil
+}
+
+func (p *gqlParser) unexpected(t gqlToken) error {
+	return p.errorAt(t.pos, "Unexpected %s", describeToken(t))
+}
+
+func (p *gqlParser) errorAt(pos int, format string, args ...interface{}) error {
+	return &GraphQLError{
+		Message:   "Syntax Error: " + fmt.Sprintf(format, args...),
+		Locations: []GraphQLLocation{graphQLLocation(p.source, pos)},
+	}
+}
+
+func (p *gqlParser) operation() (*gqlOperation, error) {
+	t := p.next()
+	op := &gqlOperation{kind: t.value, pos: t.pos}
+	if p.peek().kind == gqlName {
+		op.name = p.next().value
+	}
+	if p.isPunct("(") {
+		p.next()
+		for !p.isPunct(")") {
+			pos := p.peek().pos
+			if err := p.expect("$"); err != nil {
+				return nil, err
+			}
+			name, err := p.name()
+			if err != nil {
+				return nil, err
+			}
+			if err := p.expect(":"); err != nil {
+				return nil, err
+			}
+			typ, err := p.typeRef()
+			if err != nil {
+				return nil, err
+			}
+			v := gqlVariable{name: name, typ: typ, pos: pos}
+			if p.isPunct("=") {
+				p.next()
+				def, err := p.value(true)
+				if err != nil {
+					return nil, err
+				}
+				v.def = &def
+			}
+			op.variables = append(op.variables, v)
+		}
+		p.next()
+	}
+	if _, err := p.directives(); err != nil {
+		return nil, err
+	}
+	selections, err := p.selectionSet()
+	if err != nil {
+		return nil, err
+	}
+	op.selections = selections
+
+	return op, nil
+}
+
+func (p *gqlParser) typeRef() (string, error) {
+	var typ string
+	if p.isPunct("[") {
+		if err := p.enter(); err != nil {
+			return "", err
+		}
+		defer p.leave()
+		p.next()
+		inner, err := p.typeRef()
+		if err != nil {
+			return "", err
+		}
+		if err := p.expect("]"); err != nil {
+			return "", err
+		}
+		typ = "[" + inner + "]"
+	} else {
+		name, err := p.name()
+		if err != nil {
+			return "", err
+		}
+		typ = name
+	}
+	if p.isPunct("!") {
+		p.next()
+		typ += "!"
+	}
+
+	return typ, nil
+}
+
+// enter starts a nested selection set, value or type at the next token,
+// failing past maxGraphQLNesting levels.
+func (p *gqlParser) enter() error {
+	if p.depth++; p.depth > maxGraphQLNesting {
+		return p.errorAt(p.peek().pos, "Nesting exceeds the maximum of %d levels", maxGraphQLNesting)
+	}
+
+	return nil
+}
+
+func (p *gqlParser) leave() {
+	p.depth--
+}
+
+func (p *gqlParser) selectionSet() ([]gqlSelection, error) {
+	if err := p.enter(); err != nil {
+		return nil, err
+	}
+	defer p.leave()
+	if err := p.expect("{"); err != nil {
+		return nil, err
+	}
+	var selections []gqlSelection
+	for !p.isPunct("}") {
+		if p.peek().kind == gqlEOF {
+			return nil, p.unexpected(p.peek())
+		}
+		s, err := p.selection()
+		if err != nil {
+			return nil, err
+		}
+		selections = append(selections, s)
+	}
+	p.next()
+	if len(selections) == 0 {
+		return nil, p.errorAt(p.tokens[p.i-1].pos, "Empty selection set")
+	}
+
+	return selections, nil
+}
+
+func (p *gqlParser) selection() (gqlSelection, error) {
+	pos := p.peek().pos
+	if p.isPunct("...") {
+		p.next()
+		if t := p.peek(); t.kind == gqlName && t.value != "on" {
+			p.next()
+			directives, err := p.directives()
+			return gqlSelection{kind: gqlFragmentSpread, name: t.value, directives: directives, pos: pos}, err
+		}
+		s := gqlSelection{kind: gqlInlineFragment, pos: pos}
+		if t := p.peek(); t.kind == gqlName && t.value == "on" {
+			p.next()
+			name, err := p.name()
+			if err != nil {
+				return s, err
+			}
+			s.typeCondition = name
+		}
+		directives, err := p.directives()
+		if err != nil {
+			return s, err
+		}
+		s.directives = directives
+		s.selections, err = p.selectionSet()
+		return s, err
+	}
+
+	name, err := p.name()
+	if err != nil {
+		return gqlSelection{}, err
+	}
+	s := gqlSelection{kind: gqlFieldSelection, alias: name, name: name, pos: pos}
+	if p.isPunct(":") {
+		p.next()
+		if s.name, err = p.name(); err != nil {
+			return s, err
+		}
+	}
+	if s.args, err = p.arguments(); err != nil {
+		return s, err
+	}
+	if s.directives, err = p.directives(); err != nil {
+		return s, err
+	}
+	if p.isPunct("{") {
+		s.selections, err = p.selectionSet()
+	}
+
+	return s, err
+}
+
+func (p *gqlParser) arguments() ([]gqlArgument, error) {
+	if !p.isPunct("(") {
+		return nil, nil
+	}
+	p.next()
+	var args []gqlArgument
+	for !p.isPunct(")") {
+		name, err := p.name()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expect(":"); err != nil {
+			return nil, err
+		}
+		value, err := p.value(false)
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, gqlArgument{name: name, value: value})
+	}
+	p.next()
+
+	return args, nil
+}
+
+func (p *gqlParser) directives() ([]gqlDirective, error) {
+	var directives []gqlDirective
+	for p.isPunct("@") {
+		p.next()
+		name, err := p.name()
+		if err != nil {
+			return nil, err
+		}
+		args, err := p.arguments()
+		if err != nil {
+			return nil, err
+		}
+		directives = append(directives, gqlDirective{name: name, args: args})
+	}
+
+	return directives, nil
+}
+
+func (p *gqlParser) value(constant bool) (gqlValue, error) {
+	if p.isPunct("[") || p.isPunct("{") {
+		if err := p.enter(); err != nil {
+			return gqlValue{}, err
+		}
+		defer p.leave()
+	}
+	t := p.next()
+	switch t.kind {
+	case gqlInt:
+		return gqlValue{kind: gqlIntValue, raw: t.value}, nil
+	case gqlFloat:
+		return gqlValue{kind: gqlFloatValue, raw: t.value}, nil
+	case gqlString:
+		return gqlValue{kind: gqlStringValue, raw: t.value}, nil
+	case gqlName:
+		switch t.value {
+		case "true", "false":
+			return gqlValue{kind: gqlBooleanValue, raw: t.value}, nil
+		case "null":
+			return gqlValue{kind: gqlNullValue}, nil
+		}
+		return gqlValue{kind: gqlEnumValue, raw: t.value}, nil
+	case gqlPunct:
+		switch t.value {
+		case "$":
+			if constant {
+				break
+			}
+			name, err := p.name()
+			return gqlValue{kind: gqlVariableValue, raw: name}, err
+		case "[":
+			v := gqlValue{kind: gqlListValue}
+			for !p.isPunct("]") {
+				if p.peek().kind == gqlEOF {
+					return v, p.unexpected(p.peek())
+				}
+				item, err := p.value(constant)
+				if err != nil {
+					return v, err
+				}
+				v.list = append(v.list, item)
+			}
+			p.next()
+			return v, nil
+		case "{":
+			v := gqlValue{kind: gqlObjectValue}
+			for !p.isPunct("}") {
+				name, err := p.name()
+				if err != nil {
+					return v, err
+				}
+				if err := p.expect(":"); err != nil {
+					return v, err
+				}
+				item, err := p.value(constant)
+				if err != nil {
+					return v, err
+				}
+				v.fields = append(v.fields, gqlArgument{name: name, value: item})
+			}
+			p.next()
+			return v, nil
+		}
+	}
+
+	return gqlValue{}, p.unexpected(t)
+}
+
+func describeToken(t gqlToken) string {
+	switch t.kind {
+	case gqlEOF:
+		return "<EOF>"
+	case gqlString:
+		return "string " + strconv.Quote(t.value)
+	case gqlName:
+		return "name " + strconv.Quote(t.value)
+	case gqlInt, gqlFloat:
+		return "number " + t.value
+	}
+
+	return strconv.Quote(t.value)
+}
+
+// lexGraphQL splits a GraphQL document into tokens. Commas are ignored, as
+// the specification treats them as white space.
+func lexGraphQL(source string) ([]gqlToken, error) {
+	var tokens []gqlToken
+	fail := func(pos int, format string, args ...interface{}) ([]gqlToken, error) {
+		return nil, &GraphQLError{
+			Message:   "Syntax Error: " + fmt.Sprintf(format, args...),
+			Locations: []GraphQLLocation{graphQLLocation(source, pos)},
+		}
+	}
+
+	for i := 0; i < len(source); {
+		c := source[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == ',':
+			i++
+		case c == '#':
+			for i < len(source) && source[i] != '\n' && source[i] != '\r' {
+				i++
+			}
+		case strings.HasPrefix(source[i:], "..."):
+			tokens = append(tokens, gqlToken{gqlPunct, "...", i})
+			i += 3
+		case strings.IndexByte("!$&()/:=@[]{}|", c) >= 0:
+			tokens = append(tokens, gqlToken{gqlPunct, string(c), i})
+			i++
+		case c == '_' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z':
+			start := i
+			for i < len(source) && (source[i] == '_' || source[i] >= 'a' && source[i] <= 'z' || source[i] >= 'A' && source[i] <= 'Z' || source[i] >= '0' && source[i] <= '9') {
+				i++
+			}
+			tokens = append(tokens, gqlToken{gqlName, source[start:i], start})
+		case c == '-' || c >= '0' && c <= '9':
+			start := i
+			kind := gqlInt
+			if c == '-' {
+				i++
+			}
+			digits := func() int {
+				n := 0
+				for i < len(source) && source[i] >= '0' && source[i] <= '9' {
+					i++
+					n++
+				}
+				return n
+			}
+			if digits() == 0 {
+				return fail(start, "Invalid number")
+			}
+			if i < len(source) && source[i] == '.' {
+				i++
+				kind = gqlFloat
+				if digits() == 0 {
+					return fail(start, "Invalid number")
+				}
+			}
+			if i < len(source) && (source[i] == 'e' || source[i] == 'E') {
+				i++
+				kind = gqlFloat
+				if i < len(source) && (source[i] == '+' || source[i] == '-') {
+					i++
+				}
+				if digits() == 0 {
+					return fail(start, "Invalid number")
+				}
+			}
+			tokens = append(tokens, gqlToken{kind, source[start:i], start})
+		case strings.HasPrefix(source[i:], `"""`):
+			end := strings.Index(source[i+3:], `"""`)
+			if end < 0 {
+				return fail(i, "Unterminated string")
+			}
+			tokens = append(tokens, gqlToken{gqlString, strings.TrimSpace(source[i+3 : i+3+end]), i})
+			i += end + 6
+		case c == '"':
+			start := i
+			sb := strings.Builder{}
+			i++
+			for {
+				if i >= len(source) || source[i] == '\n' || source[i] == '\r' {
+					return fail(start, "Unterminated string")
+				}
+				if source[i] == '"' {
+					i++
+					break
+				}
+				if source[i] != '\\' {
+					r, size := utf8.DecodeRuneInString(source[i:])
+					sb.WriteRune(r)
+					i += size
+					continue
+				}
+				if i+1 >= len(source) {
+					return fail(start, "Unterminated string")
+				}
+				switch e := source[i+1]; e {
+				case '"', '\\', '/':
+					sb.WriteByte(e)
+				case 'b':
+					sb.WriteByte('\b')
+				case 'f':
+					sb.WriteByte('\f')
+				case 'n':
+					sb.WriteByte('\n')
+				case 'r':
+					sb.WriteByte('\r')
+				case 't':
+					sb.WriteByte('\t')
+				case 'u':
+					if i+6 > len(source) {
+						return fail(i, "Invalid unicode escape")
+					}
+					r, err := strconv.ParseUint(source[i+2:i+6], 16, 32)
+					if err != nil {
+						return fail(i, "Invalid unicode escape")
+					}
+					sb.WriteRune(rune(r))
+					i += 4
+				default:
+					return fail(i, "Invalid escape sequence \\%c", e)
+				}
+				i += 2
+			}
+			tokens = append(tokens, gqlToken{gqlString, sb.String(), start})
+		default:
+			r, _ := utf8.DecodeRuneInString(source[i:])
+			return fail(i, "Unexpected character %q", r)
+		}
+	}
+
+	return append(tokens, gqlToken{kind: gqlEOF, pos: len(source)}), nil
+}
+
+func graphQLLocation(source string, pos int) GraphQLLocation {
+	if pos > len(source) {
+		pos = len(source)
+	}
+	before := source[:pos]
+	line := strings.Count(before, "\n") + 1
+	column := utf8.RuneCountInString(before[strings.LastIndex(before, "\n")+1:]) + 1
+
+	return GraphQLLocation{Line: line, Column: column}
+}
+
+// Execution
+
+// gqlSchema holds the object types of a schema, starting with Query.
+type gqlSchema struct {
+	types []*gqlObject
+}
+
+type gqlObject struct {
+	name        string
+	description string
+	fields      []*gqlField
+}
+
+type gqlField struct {
+	name    string
+	typ     string
+	args    []gqlArg
+	resolve func(source interface{}, args map[string]interface{}) (interface{}, error)
+}
+
+type gqlArg struct {
+	name string
+	typ  string
+}
+
+func (o *gqlObject) field(name string) *gqlField {
+	for _, f := range o.fields {
+		if f.name == name {
+			return f
+		}
+	}
+
+	return nil
+}
+
+func (s *gqlSchema) object(name string) *gqlObject {
+	for _, t := range s.types {
+		if t.name == name {
+			return t
+		}
+	}
+
+	return nil
+}
+
+// sdl writes the schema in the GraphQL schema language.
+func (s *gqlSchema) sdl() string {
+	buf := bytes.Buffer{}
+	for i, t := range s.types {
+		if i > 0 {
+			buf.WriteByte('\n')
+		}
+		if t.description != "" {
+			fmt.Fprintf(&buf, "%q\n", t.description)
+		}
+		fmt.Fprintf(&buf, "type %s {\n", t.name)
+		for _, f := range t.fields {
+			args := make([]string, len(f.args))
+			for i, a := range f.args {
+				args[i] = a.name + ": " + a.typ
+			}
+			if len(args) > 0 {
+				fmt.Fprintf(&buf, "  %s(%s): %s\n", f.name, strings.Join(args, ", "), f.typ)
+			} else {
+				fmt.Fprintf(&buf, "  %s: %s\n", f.name, f.typ)
+			}
+		}
+		buf.WriteString("}\n")
+	}
+
+	return buf.String()
+}
+
+// gqlExecution is the state of a running query.
+type gqlExecution struct {
+	schema    *gqlSchema
+	doc       *gqlDocument
+	variables map[string]interface{}
+	errors    []*GraphQLError
+	maxFields int
+	// resolved is the number of fields resolved so far.
+	resolved int
+}
+
+func (s *gqlSchema) execute(doc *gqlDocument, variables map[string]interface{}, operationName string, maxDepth, maxFields int) *GraphQLResponse {
+	var op *gqlOperation
+	for _, o := range doc.operations {
+		if operationName == "" && len(doc.operations) == 1 || o.name == operationName && operationName != "" {
+			op = o
+			break
+		}
+	}
+	if op == nil {
+		if operationName == "" {
+			return graphQLFailure("Must provide operation name if query contains multiple operations")
+		}
+		return graphQLFailure("Unknown operation named %q", operationName)
+	}
+	if op.kind != "query" {
+		return graphQLFailure("Operation %s is not supported", op.kind)
+	}
+
+	e := &gqlExecution{schema: s, doc: doc, variables: map[string]interface{}{}, maxFields: maxFields}
+	if deep := e.tooDeep(op.selections, maxDepth, map[string]bool{}); deep != nil {
+		return &GraphQLResponse{Errors: []*GraphQLError{e.errorAt(deep.pos, nil, "Query exceeds the maximum depth of %d", maxDepth)}}
+	}
+	for _, v := range op.variables {
+		value, provided := variables[v.name]
+		if !provided && v.def != nil {
+			var err error
+			if value, err = e.valueOf(*v.def); err != nil {
+				return &GraphQLResponse{Errors: []*GraphQLError{e.errorAt(v.pos, nil, "%v", err)}}
+			}
+			provided = true
+		}
+		if !provided && strings.HasSuffix(v.typ, "!") {
+			return &GraphQLResponse{Errors: []*GraphQLError{e.errorAt(v.pos, nil, "Variable $%s of required type %s was not provided", v.name, v.typ)}}
+		}
+		if provided {
+			coerced, err := coerceGraphQL(v.typ, value)
+			if err != nil {
+				return &GraphQLResponse{Errors: []*GraphQLError{e.errorAt(v.pos, nil, "Variable $%s: %v", v.name, err)}}
+			}
+			e.variables[v.name] = coerced
+		}
+	}
+
+	data := e.selectionSet(s.types[0], nil, op.selections, nil)
+
+	return &GraphQLResponse{Data: data, Errors: e.errors}
+}
+
+func (e *gqlExecution) errorAt(pos int, path []interface{}, format string, args ...interface{}) *GraphQLError {
+	err := &GraphQLError{
+		Message:   fmt.Sprintf(format, args...),
+		Locations: []GraphQLLocation{graphQLLocation(e.doc.source, pos)},
+	}
+	if path != nil {
+		err.Path = append([]interface{}(nil), path...)
+	}
+
+	return err
+}
+
+// gqlFields is an ordered JSON object.
+type gqlFields struct {
+	keys   []string
+	values map[string]interface{}
+}
+
+func (f *gqlFields) MarshalJSON() ([]byte, error) {
+	buf := bytes.Buffer{}
+	buf.WriteByte('{')
+	for i, k := range f.keys {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		key, _ := json.Marshal(k)
+		value, err := json.Marshal(f.values[k])
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(key)
+		buf.WriteByte(':')
+		buf.Write(value)
+	}
+	buf.WriteByte('}')
+
+	return buf.Bytes(), nil
+}
+
+// tooDeep returns a field nested deeper than depth levels in the selections,
+// following fragments, or nil when there is none.
+func (e *gqlExecution) tooDeep(selections []gqlSelection, depth int, visited map[string]bool) *gqlSelection {
+	for i := range selections {
+		s := &selections[i]
+		switch s.kind {
+		case gqlFieldSelection:
+			if depth < 1 {
+				return s
+			}
+			if deep := e.tooDeep(s.selections, depth-1, visited); deep != nil {
+				return deep
+			}
+		case gqlInlineFragment:
+			if deep := e.tooDeep(s.selections, depth, visited); deep != nil {
+				return deep
+			}
+		case gqlFragmentSpread:
+			fragment, ok := e.doc.fragments[s.name]
+			if !ok || visited[s.name] {
+				continue
+			}
+			visited[s.name] = true
+			deep := e.tooDeep(fragment.selections, depth, visited)
+			delete(visited, s.name)
+			if deep != nil {
+				return deep
+			}
+		}
+	}
+
+	return nil
+}
+
+// collect flattens fragments and skipped selections, grouping fields by response key.
+func (e *gqlExecution) collect(typ *gqlObject, selections []gqlSelection, keys *[]string, fields map[string][]gqlSelection, visited map[string]bool) {
+	for _, s := range selections {
+		if !e.included(s) {
+			continue
+		}
+		switch s.kind {
+		case gqlFieldSelection:
+			if _, ok := fields[s.alias]; !ok {
+				*keys = append(*keys, s.alias)
+			}
+			fields[s.alias] = append(fields[s.alias], s)
+		case gqlInlineFragment:
+			if s.typeCondition == "" || s.typeCondition == typ.name {
+				e.collect(typ, s.selections, keys, fields, visited)
+			}
+		case gqlFragmentSpread:
+			fragment, ok := e.doc.fragments[s.name]
+			if !ok {
+				e.errors = append(e.errors, e.errorAt(s.pos, nil, "Unknown fragment %q", s.name))
+				continue
+			}
+			if visited[s.name] || fragment.typeCondition != typ.name {
+				continue
+			}
+			visited[s.name] = true
+			e.collect(typ, fragment.selections, keys, fields, visited)
+			delete(visited, s.name)
+		}
+	}
+}
+
+// included applies the @skip and @include directives.
+func (e *gqlExecution) included(s gqlSelection) bool {
+	for _, d := range s.directives {
+		if d.name != "skip" && d.name != "include" {
+			continue
+		}
+		var cond bool
+		for _, a := range d.args {
+			if a.name == "if" {
+				v, _ := e.valueOf(a.value)
+				cond, _ = v.(bool)
+			}
+		}
+		if d.name == "skip" && cond || d.name == "include" && !cond {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (e *gqlExecution) selectionSet(typ *gqlObject, source interface{}, selections []gqlSelection, path []interface{}) *gqlFields {
+	var keys []string
+	fields := map[string][]gqlSelection{}
+	e.collect(typ, selections, &keys, fields, map[string]bool{})
+
+	result := &gqlFields{values: map[string]interface{}{}}
+	for _, key := range keys {
+		s := fields[key][0]
+		var sub []gqlSelection
+		for _, f := range fields[key] {
+			sub = append(sub, f.selections...)
+		}
+		fieldPath := append(append([]interface{}(nil), path...), key)
+		result.keys = append(result.keys, key)
+
+		if e.resolved++; e.resolved > e.maxFields {
+			if e.resolved == e.maxFields+1 {
+				e.errors = append(e.errors, e.errorAt(s.pos, fieldPath, "Query exceeds the maximum of %d resolved fields", e.maxFields))
+			}
+			result.values[key] = nil
+			continue
+		}
+
+		if s.name == "__typename" {
+			result.values[key] = typ.name
+			continue
+		}
+		def := typ.field(s.name)
+		if def == nil {
+			e.errors = append(e.errors, e.errorAt(s.pos, fieldPath, "Cannot query field %q on type %q", s.name, typ.name))
+			result.values[key] = nil
+			continue
+		}
+		args, err := e.arguments(def, s)
+		if err != nil {
+			e.errors = append(e.errors, e.errorAt(s.pos, fieldPath, "%v", err))
+			result.values[key] = nil
+			continue
+		}
+		value, err := def.resolve(source, args)
+		if err != nil {
+			e.errors = append(e.errors, e.errorAt(s.pos, fieldPath, "%v", err))
+			result.values[key] = nil
+			continue
+		}
+		result.values[key] = e.complete(def.typ, value, s, sub, fieldPath)
+	}
+
+	return result
+}
+
+func (e *gqlExecution) arguments(def *gqlField, s gqlSelection) (map[string]interface{}, error) {
+	args := map[string]interface{}{}
+	for _, a := range s.args {
+		found := false
+		for _, d := range def.args {
+			found = found || d.name == a.name
+		}
+		if !found {
+			return nil, fmt.Errorf("Unknown argument %q on field %q", a.name, def.name)
+		}
+	}
+	for _, d := range def.args {
+		var value interface{}
+		provided := false
+		for _, a := range s.args {
+			if a.name != d.name {
+				continue
+			}
+			if a.value.kind == gqlVariableValue {
+				value, provided = e.variables[a.value.raw]
+				break
+			}
+			v, err := e.valueOf(a.value)
+			if err != nil {
+				return nil, err
+			}
+			value, provided = v, true
+		}
+		if !provided || value == nil {
+			if strings.HasSuffix(d.typ, "!") {
+				return nil, fmt.Errorf("Argument %q of type %s is required", d.name, d.typ)
+			}
+			continue
+		}
+		coerced, err := coerceGraphQL(d.typ, value)
+		if err != nil {
+			return nil, fmt.Errorf("Argument %q: %v", d.name, err)
+		}
+		args[d.name] = coerced
+	}
+
+	return args, nil
+}
+
+// valueOf converts a literal to a Go value, replacing variables by their values.
+func (e *gqlExecution) valueOf(v gqlValue) (interface{}, error) {
+	switch v.kind {
+	case gqlVariableValue:
+		value, ok := e.variables[v.raw]
+		if !ok {
+			return nil, nil
+		}
+		return value, nil
+	case gqlIntValue:
+		return strconv.Atoi(v.raw)
+	case gqlFloatValue:
+		return strconv.ParseFloat(v.raw, 64)
+	case gqlStringValue, gqlEnumValue:
+		return v.raw, nil
+	case gqlBooleanValue:
+		return v.raw == "true", nil
+	case gqlListValue:
+		list := make([]interface{}, len(v.list))
+		for i, item := range v.list {
+			value, err := e.valueOf(item)
+			if err != nil {
+				return nil, err
+			}
+			list[i] = value
+		}
+		return list, nil
+	case gqlObjectValue:
+		object := map[string]interface{}{}
+		for _, f := range v.fields {
+			value, err := e.valueOf(f.value)
+			if err != nil {
+				return nil, err
+			}
+			object[f.name] = value
+		}
+		return object, nil
+	}
+
+	return nil, nil
+}
+
+// coerceGraphQL converts a value to the given input type, e.g. [String!] or Int.
+func coerceGraphQL(typ string, value interface{}) (interface{}, error) {
+	nonNull := strings.HasSuffix(typ, "!")
+	typ = strings.TrimSuffix(typ, "!")
+	if value == nil {
+		if nonNull {
+			return nil, fmt.Errorf("Expected non-null %s", typ)
+		}
+		return nil, nil
+	}
+
+	if strings.HasPrefix(typ, "[") {
+		inner := typ[1 : len(typ)-1]
+		items, ok := value.([]interface{})
+		if !ok {
+			items = []interface{}{value}
+		}
+		list := make([]interface{}, len(items))
+		for i, item := range items {
+			coerced, err := coerceGraphQL(inner, item)
+			if err != nil {
+				return nil, err
+			}
+			list[i] = coerced
+		}
+		return list, nil
+	}
+
+	switch typ {
+	case "String", "ID":
+		if s, ok := value.(string); ok {
+			return s, nil
+		}
+	case "Int":
+		switch n := value.(type) {
+		case int:
+			return n, nil
+		case float64:
+			if n == float64(int(n)) {
+				return int(n), nil
+			}
+		}
+	case "Float":
+		switch n := value.(type) {
+		case int:
+			return float64(n), nil
+		case float64:
+			return n, nil
+		}
+	case "Boolean":
+		if b, ok := value.(bool); ok {
+			return b, nil
+		}
+	default:
+		return nil, fmt.Errorf("Unknown input type %s", typ)
+	}
+
+	return nil, fmt.Errorf("Expected %s, got %v", typ, value)
+}
+
+// complete converts a resolved value to its response value, following the field type.
+func (e *gqlExecution) complete(typ string, value interface{}, s gqlSelection, sub []gqlSelection, path []interface{}) interface{} {
+	typ = strings.TrimSuffix(typ, "!")
+	if e.resolved > e.maxFields {
+		return nil
+	}
+	if strings.HasPrefix(typ, "[") {
+		inner := typ[1 : len(typ)-1]
+		v := reflect.ValueOf(value)
+		if value == nil || v.Kind() != reflect.Slice {
+			return []interface{}{}
+		}
+		list := make([]interface{}, v.Len())
+		for i := range list {
+			list[i] = e.complete(inner, v.Index(i).Interface(), s, sub, append(append([]interface{}(nil), path...), i))
+		}
+		return list
+	}
+
+	object := e.schema.object(typ)
+	if object == nil {
+		if len(sub) > 0 {
+			e.errors = append(e.errors, e.errorAt(s.pos, path, "Field %q must not have a selection since type %q has no subfields", s.name, typ))
+			return nil
+		}
+		return graphQLScalar(value)
+	}
+	if value == nil || reflect.ValueOf(value).Kind() == reflect.Ptr && reflect.ValueOf(value).IsNil() {
+		return nil
+	}
+	if len(sub) == 0 {
+		e.errors = append(e.errors, e.errorAt(s.pos, path, "Field %q of type %q must have a selection of subfields", s.name, typ))
+		return nil
+	}
+
+	return e.selectionSet(object, value, sub, path)
+}
+
+// graphQLScalar converts a Go value to a JSON scalar, keeping the shortest
+// decimal form of float32 values.
+func graphQLScalar(value interface{}) interface{} {
+	switch v := value.(type) {
+	case float32:
+		f, _ := strconv.ParseFloat(strconv.FormatFloat(float64(v), 'g', -1, 32), 64)
+		return f
+	case int32:
+		return int(v)
+	}
+
+	return value
+}
+
+// sortedGraphQLKeys returns the keys of a map, sorted.
+func sortedGraphQLKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
@@ -0,0 +1,255 @@
+package countries
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// Translation is a translated country name, as exposed by the GraphQL schema.
+type Translation struct {
+	Lang string `json:"lang"`
+	Name string `json:"name"`
+}
+
+// countryGraph resolves the relations between the countries of a dataset.
+type countryGraph struct {
+	countries []Country
+	codes     map[string]int
+}
+
+// newCountrySchema builds the GraphQL schema over the countries. Object types
+// mirror Country, Currency, Language and RegionalBloc, with their JSON field
+// names, and add fields resolving the related countries.
+func newCountrySchema(countries []Country) *gqlSchema {
+	g := &countryGraph{countries: countries, codes: map[string]int{}}
+	for i, c := range countries {
+		g.codes[strings.ToUpper(c.Alpha3Code)] = i
+	}
+
+	country := structObject("Country", Country{}, "borders", "translations")
+	country.fields = append(country.fields,
+		&gqlField{name: "borders", typ: "[Country!]!", resolve: func(source interface{}, _ map[string]interface{}) (interface{}, error) {
+			return g.lookup(source.(Country).Borders), nil
+		}},
+		&gqlField{name: "borderCodes", typ: "[String!]!", resolve: func(source interface{}, _ map[string]interface{}) (interface{}, error) {
+			return source.(Country).Borders, nil
+		}},
+		&gqlField{name: "translations", typ: "[Translation!]!", resolve: func(source interface{}, _ map[string]interface{}) (interface{}, error) {
+			t := source.(Country).Translations
+			translations := make([]Translation, 0, len(t))
+			for _, lang := range sortedGraphQLKeys(t) {
+				translations = append(translations, Translation{Lang: lang, Name: t[lang]})
+			}
+			return translations, nil
+		}},
+		&gqlField{name: "translation", typ: "String", args: []gqlArg{{"lang", "String!"}}, resolve: func(source interface{}, args map[string]interface{}) (interface{}, error) {
+			name, ok := source.(Country).Translations[args["lang"].(string)]
+			if !ok {
+				return nil, nil
+			}
+			return name, nil
+		}},
+	)
+
+	currency := structObject("Currency", Currency{})
+	currency.fields = append(currency.fields, &gqlField{name: "countries", typ: "[Country!]!", resolve: func(source interface{}, _ map[string]interface{}) (interface{}, error) {
+		return g.filter(map[string]interface{}{"currency": source.(Currency).Code}), nil
+	}})
+
+	language := structObject("Language", Language{})
+	language.fields = append(language.fields, &gqlField{name: "countries", typ: "[Country!]!", resolve: func(source interface{}, _ map[string]interface{}) (interface{}, error) {
+		l := source.(Language)
+		code := l.Iso6392
+		if code == "" {
+			code = l.Iso6391
+		}
+		return g.filter(map[string]interface{}{"language": code}), nil
+	}})
+
+	bloc := structObject("RegionalBloc", RegionalBloc{})
+	bloc.fields = append(bloc.fields, &gqlField{name: "members", typ: "[Country!]!", resolve: func(source interface{}, _ map[string]interface{}) (interface{}, error) {
+		return g.filter(map[string]interface{}{"bloc": source.(RegionalBloc).Acronym}), nil
+	}})
+
+	translation := structObject("Translation", Translation{})
+
+	filters := []gqlArg{
+		{"name", "String"}, {"region", "String"}, {"subregion", "String"}, {"currency", "String"},
+		{"language", "String"}, {"bloc", "String"}, {"callingCode", "String"}, {"codes", "[String!]"},
+		{"first", "Int"},
+	}
+	query := &gqlObject{name: "Query", fields: []*gqlField{
+		{name: "countries", typ: "[Country!]!", args: filters, resolve: func(_ interface{}, args map[string]interface{}) (interface{}, error) {
+			return g.filter(args), nil
+		}},
+		{name: "country", typ: "Country", args: []gqlArg{{"code", "String!"}}, resolve: func(_ interface{}, args map[string]interface{}) (interface{}, error) {
+			result := g.filter(map[string]interface{}{"codes": []interface{}{args["code"]}})
+			if len(result) == 0 {
+				return nil, nil
+			}
+			return result[0], nil
+		}},
+		{name: "regionalBlocs", typ: "[RegionalBloc!]!", resolve: func(_ interface{}, _ map[string]interface{}) (interface{}, error) {
+			return g.blocs(""), nil
+		}},
+		{name: "regionalBloc", typ: "RegionalBloc", args: []gqlArg{{"acronym", "String!"}}, resolve: func(_ interface{}, args map[string]interface{}) (interface{}, error) {
+			blocs := g.blocs(args["acronym"].(string))
+			if len(blocs) == 0 {
+				return nil, nil
+			}
+			return blocs[0], nil
+		}},
+	}}
+
+	return &gqlSchema{types: []*gqlObject{query, country, currency, language, bloc, translation}}
+}
+
+// structObject returns an object type with a field per JSON field of the struct,
+// except the given ones, which the caller resolves.
+func structObject(name string, value interface{}, except ...string) *gqlObject {
+	t := reflect.TypeOf(value)
+	o := &gqlObject{name: name}
+	for _, f := range jsonFields(t) {
+		if containsCode(except, f.name) {
+			continue
+		}
+		index := f.index
+		o.fields = append(o.fields, &gqlField{
+			name: f.name,
+			typ:  graphQLType(t.Field(index).Type),
+			resolve: func(source interface{}, _ map[string]interface{}) (interface{}, error) {
+				return reflect.ValueOf(source).Field(index).Interface(), nil
+			},
+		})
+	}
+
+	return o
+}
+
+// graphQLType returns the GraphQL type of a Go field type.
+func graphQLType(t reflect.Type) string {
+	switch t.Kind() {
+	case reflect.Slice:
+		return "[" + graphQLType(t.Elem()) + "!]!"
+	case reflect.String:
+		return "String"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return "Int"
+	case reflect.Float32, reflect.Float64:
+		return "Float"
+	case reflect.Bool:
+		return "Boolean"
+	case reflect.Struct:
+		return t.Name()
+	}
+
+	panic(fmt.Sprintf("no GraphQL type for %s", t))
+}
+
+// lookup returns the known countries with the given alpha-3 codes, in order.
+func (g *countryGraph) lookup(codes []string) []Country {
+	var countries []Country
+	for _, code := range codes {
+		if i, ok := g.codes[strings.ToUpper(code)]; ok {
+			countries = append(countries, g.countries[i])
+		}
+	}
+
+	return countries
+}
+
+// filter returns the countries matching every filter argument of the countries query.
+func (g *countryGraph) filter(args map[string]interface{}) []Country {
+	first := -1
+	if n, ok := args["first"].(int); ok && n >= 0 {
+		first = n
+	}
+
+	result := []Country{}
+	for _, c := range g.countries {
+		if first >= 0 && len(result) >= first {
+			break
+		}
+		if countryMatches(c, args) {
+			result = append(result, c)
+		}
+	}
+
+	return result
+}
+
+func countryMatches(c Country, args map[string]interface{}) bool {
+	if name, ok := args["name"].(string); ok {
+		n := fold(name)
+		if !strings.Contains(fold(c.Name), n) && !strings.Contains(fold(c.NativeName), n) {
+			return false
+		}
+	}
+	if region, ok := args["region"].(string); ok && !strings.EqualFold(c.Region, region) {
+		return false
+	}
+	if subregion, ok := args["subregion"].(string); ok && !strings.EqualFold(c.Subregion, subregion) {
+		return false
+	}
+	if code, ok := args["callingCode"].(string); ok && !containsCode(c.CallingCodes, strings.TrimPrefix(code, "+")) {
+		return false
+	}
+	if codes, ok := args["codes"].([]interface{}); ok {
+		found := false
+		for _, code := range codes {
+			s, _ := code.(string)
+			found = found || strings.EqualFold(c.Alpha2Code, s) || strings.EqualFold(c.Alpha3Code, s)
+		}
+		if !found {
+			return false
+		}
+	}
+	if code, ok := args["currency"].(string); ok {
+		found := false
+		for _, cur := range c.Currencies {
+			found = found || strings.EqualFold(cur.Code, code)
+		}
+		if !found {
+			return false
+		}
+	}
+	if code, ok := args["language"].(string); ok {
+		found := false
+		for _, l := range c.Languages {
+			found = found || strings.EqualFold(l.Iso6391, code) || strings.EqualFold(l.Iso6392, code)
+		}
+		if !found {
+			return false
+		}
+	}
+	if acronym, ok := args["bloc"].(string); ok {
+		found := false
+		for _, b := range c.RegionalBlocs {
+			found = found || strings.EqualFold(b.Acronym, acronym)
+		}
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+// blocs returns the distinct regional blocs of the dataset, or only the one
+// with the given acronym.
+func (g *countryGraph) blocs(acronym string) []RegionalBloc {
+	blocs := []RegionalBloc{}
+	var seen []string
+	for _, c := range g.countries {
+		for _, b := range c.RegionalBlocs {
+			if containsCode(seen, b.Acronym) || acronym != "" && !strings.EqualFold(b.Acronym, acronym) {
+				continue
+			}
+			seen = append(seen, b.Acronym)
+			blocs = append(blocs, b)
+		}
+	}
+
+	return blocs
+}
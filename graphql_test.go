@@ -0,0 +1,190 @@
+package countries_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/georgesafta/countries"
+)
+
+func execute(t *testing.T, h *countries.GraphQLHandler, query string, variables map[string]interface{}) string {
+	res := h.Execute(query, variables, "")
+	data, err := json.Marshal(res)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return string(data)
+}
+
+func TestGraphQLBorders(t *testing.T) {
+	h := countries.NewGraphQLHandler(loadDataset(t))
+
+	out := execute(t, h, `{
+		country(code: "col") {
+			name
+			borders { name currencies { code } }
+			borderCodes
+		}
+	}`, nil)
+	expected := `{"data":{"country":{"name":"Colombia","borders":[` +
+		`{"name":"Brazil","currencies":[{"code":"BRL"}]},` +
+		`{"name":"Ecuador","currencies":[{"code":"USD"}]},` +
+		`{"name":"Panama","currencies":[{"code":"PAB"},{"code":"USD"}]},` +
+		`{"name":"Peru","currencies":[{"code":"PEN"}]},` +
+		`{"name":"Venezuela (Bolivarian Republic of)","currencies":[{"code":"VEF"}]}],` +
+		`"borderCodes":["BRA","ECU","PAN","PER","VEN"]}}}`
+	if out != expected {
+		t.Fatalf("Expected %s, got %s", expected, out)
+	}
+}
+
+func TestGraphQLFilters(t *testing.T) {
+	h := countries.NewGraphQLHandler(loadDataset(t))
+
+	out := execute(t, h, `query Euro($currency: String = "EUR", $n: Int) {
+		countries(region: "europe", currency: $currency, first: $n) { code: alpha3Code, area, __typename }
+		none: countries(name: "atlantis") { name }
+		pa: regionalBloc(acronym: "PA") { name members { alpha3Code } }
+		missing: country(code: "XX") { name }
+	}`, map[string]interface{}{"n": float64(2)})
+	expected := `{"data":{"countries":[{"code":"IRL","area":70273,"__typename":"Country"},{"code":"FRA","area":640679,"__typename":"Country"}],` +
+		`"none":[],"pa":{"name":"Pacific Alliance","members":[{"alpha3Code":"COL"},{"alpha3Code":"PER"},{"alpha3Code":"MEX"}]},"missing":null}}`
+	if out != expected {
+		t.Fatalf("Expected %s, got %s", expected, out)
+	}
+
+	out = execute(t, h, `{ countries(language: "de", codes: ["AUT", "CH"]) { name gini latlng translation(lang: "fr") } }`, nil)
+	expected = `{"data":{"countries":[{"name":"Austria","gini":26,"latlng":[47.333332,13.333333],"translation":"Autriche"},{"name":"Switzerland","gini":33.7,"latlng":[47,8],"translation":"Suisse"}]}}`
+	if out != expected {
+		t.Fatalf("Expected %s, got %s", expected, out)
+	}
+}
+
+func TestGraphQLFragments(t *testing.T) {
+	h := countries.NewGraphQLHandler(loadDataset(t))
+
+	out := execute(t, h, `
+		query ($withLanguages: Boolean!) {
+			country(code: "CHE") {
+				...Names
+				languages @include(if: $withLanguages) { iso639_1 }
+				currencies @skip(if: true) { code }
+				... on Country { translations { lang name } }
+			}
+		}
+		fragment Names on Country { name nativeName }
+	`, map[string]interface{}{"withLanguages": true})
+	if !strings.HasPrefix(out, `{"data":{"country":{"name":"Switzerland","nativeName":"Schweiz","languages":[{"iso639_1":"de"},{"iso639_1":"fr"},{"iso639_1":"it"}],"translations":[{"lang":"br","name":"Suíça"},`) {
+		t.Fatalf("Unexpected fragments result %s", out)
+	}
+}
+
+func TestGraphQLErrors(t *testing.T) {
+	h := countries.NewGraphQLHandler(loadDataset(t))
+
+	for query, expected := range map[string]string{
+		"{ country(code: \"COL\") { name ":                         `{"errors":[{"message":"Syntax Error: Unexpected \u003cEOF\u003e","locations":[{"line":1,"column":31}]}]}`,
+		`{ country(code: "COL") { nope } }`:                        `{"data":{"country":{"nope":null}},"errors":[{"message":"Cannot query field \"nope\" on type \"Country\"","locations":[{"line":1,"column":26}],"path":["country","nope"]}]}`,
+		`{ country { name } }`:                                     `{"data":{"country":null},"errors":[{"message":"Argument \"code\" of type String! is required","locations":[{"line":1,"column":3}],"path":["country"]}]}`,
+		`{ country(code: "COL") }`:                                 `{"data":{"country":null},"errors":[{"message":"Field \"country\" of type \"Country\" must have a selection of subfields","locations":[{"line":1,"column":3}],"path":["country"]}]}`,
+		`{ countries(first: "two") { name } }`:                     `{"data":{"countries":null},"errors":[{"message":"Argument \"first\": Expected Int, got two","locations":[{"line":1,"column":3}],"path":["countries"]}]}`,
+		`query ($code: String!) { country(code: $code) { name } }`: `{"errors":[{"message":"Variable $code of required type String! was not provided","locations":[{"line":1,"column":8}]}]}`,
+		`mutation { country(code: "COL") { name } }`:               `{"errors":[{"message":"Operation mutation is not supported"}]}`,
+	} {
+		if out := execute(t, h, query, nil); out != expected {
+			t.Fatalf("Expected %s for %q, got %s", expected, query, out)
+		}
+	}
+}
+
+func TestGraphQLLimits(t *testing.T) {
+	h := countries.NewGraphQLHandler(loadDataset(t))
+	h.MaxDepth = 3
+
+	if out := execute(t, h, `{ country(code: "COL") { borders { name } } }`, nil); !strings.HasPrefix(out, `{"data":`) {
+		t.Fatalf("Expected a query at the maximum depth to run, got %s", out)
+	}
+	for _, query := range []string{
+		`{ country(code: "COL") { borders { borders { name } } } }`,
+		`{ country(code: "COL") { ...b } } fragment b on Country { borders { ... on Country { borders { name } } } }`,
+	} {
+		expected := `{"errors":[{"message":"Query exceeds the maximum depth of 3","locations":[{"line":1,`
+		if out := execute(t, h, query, nil); !strings.HasPrefix(out, expected) {
+			t.Fatalf("Expected a depth error for %q, got %s", query, out)
+		}
+	}
+
+	// Nesting is limited while parsing, before the stack can overflow.
+	for _, query := range []string{
+		`{ countries(name: ` + strings.Repeat("[", 1<<20),
+		`{ countries(name: ` + strings.Repeat(`{a: `, 1<<18),
+		strings.Repeat("{ borders ", 1<<18),
+		`query ($a: ` + strings.Repeat("[", 1<<18),
+	} {
+		expected := `{"errors":[{"message":"Syntax Error: Nesting exceeds the maximum of 64 levels","locations":[{"line":1,`
+		if out := execute(t, h, query, nil); !strings.HasPrefix(out, expected) {
+			t.Fatalf("Expected a nesting error for %.40q, got %.200s", query, out)
+		}
+	}
+
+	// Fields are counted across list elements, and the query stops once they are exhausted.
+	h.MaxFields = 6
+	out := execute(t, h, `{ country(code: "COL") { name borders { name alpha3Code } } }`, nil)
+	expected := `{"data":{"country":{"name":"Colombia","borders":[{"name":"Brazil","alpha3Code":"BRA"},{"name":"Ecuador","alpha3Code":null},null,null,null]}},` +
+		`"errors":[{"message":"Query exceeds the maximum of 6 resolved fields","locations":[{"line":1,"column":46}],"path":["country","borders",1,"alpha3Code"]}]}`
+	if out != expected {
+		t.Fatalf("Expected %s, got %s", expected, out)
+	}
+}
+
+func TestGraphQLHandler(t *testing.T) {
+	ts := httptest.NewServer(countries.NewGraphQLHandler(loadDataset(t)))
+	defer ts.Close()
+
+	body, _ := json.Marshal(map[string]interface{}{
+		"query":     `query Capital($code: String!) { country(code: $code) { capital } }`,
+		"variables": map[string]interface{}{"code": "PER"},
+	})
+	res, err := http.Post(ts.URL, "application/json", bytes.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var out countries.GraphQLResponse
+	json.NewDecoder(res.Body).Decode(&out)
+	res.Body.Close()
+	if res.StatusCode != http.StatusOK || out.Errors != nil || out.Data.(map[string]interface{})["country"].(map[string]interface{})["capital"] != "Lima" {
+		t.Fatalf("Unexpected POST response %d %v", res.StatusCode, out)
+	}
+
+	res, err = http.Get(ts.URL + "?query=" + url.QueryEscape(`{ country(code: "PE") { name } }`))
+	if err != nil || res.StatusCode != http.StatusOK {
+		t.Fatalf("Unexpected GET response %v", err)
+	}
+	res.Body.Close()
+
+	for _, req := range []struct {
+		method, query string
+		status        int
+	}{
+		{http.MethodGet, "", http.StatusBadRequest},
+		{http.MethodGet, "?query=" + url.QueryEscape("{ country("), http.StatusBadRequest},
+		{http.MethodDelete, "", http.StatusMethodNotAllowed},
+	} {
+		r, _ := http.NewRequest(req.method, ts.URL+req.query, nil)
+		res, err := http.DefaultClient.Do(r)
+		if err != nil || res.StatusCode != req.status {
+			t.Fatalf("Expected status %d for %s %s, got %v", req.status, req.method, req.query, err)
+		}
+		res.Body.Close()
+	}
+
+	if !strings.Contains(countries.GraphQLSchema(), "  borders: [Country!]!\n") {
+		t.Fatal("Expected the schema to resolve borders to countries")
+	}
+}
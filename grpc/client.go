@@ -0,0 +1,153 @@
+package countriesgrpc
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/georgesafta/countries"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Client calls the Countries gRPC service with the arguments of the
+// countries.HTTPClient. Fields are API field names, e.g. alpha2Code, sent as a
+// field mask.
+//
+// A NotFound error is returned as a *countries.StatusError with a 404 status
+// code, as the HTTPClient returns it.
+type Client struct {
+	client CountriesClient
+}
+
+// NewClient returns a new Client calling the service over the connection.
+func NewClient(conn grpc.ClientConnInterface) *Client {
+	return &Client{client: NewCountriesClient(conn)}
+}
+
+// ByName returns the countries matching a partial name or native name.
+func (c *Client) ByName(ctx context.Context, name string, fields ...string) ([]countries.Country, error) {
+	mask, err := fieldMask(fields)
+	if err != nil {
+		return nil, err
+	}
+
+	return result(c.client.ByName(ctx, &ByNameRequest{Name: name, FieldMask: mask}))
+}
+
+// ByFullName returns the countries with the full name.
+func (c *Client) ByFullName(ctx context.Context, name string, fields ...string) ([]countries.Country, error) {
+	mask, err := fieldMask(fields)
+	if err != nil {
+		return nil, err
+	}
+
+	return result(c.client.ByName(ctx, &ByNameRequest{Name: name, FullText: true, FieldMask: mask}))
+}
+
+// ByCode returns the country with an alpha-2 or alpha-3 code.
+func (c *Client) ByCode(ctx context.Context, code string, fields ...string) ([]countries.Country, error) {
+	mask, err := fieldMask(fields)
+	if err != nil {
+		return nil, err
+	}
+
+	return result(c.client.ByCode(ctx, &ByCodeRequest{Code: code, FieldMask: mask}))
+}
+
+// ByCodes returns the countries with the alpha-2 or alpha-3 codes.
+func (c *Client) ByCodes(ctx context.Context, codes []string, fields ...string) ([]countries.Country, error) {
+	mask, err := fieldMask(fields)
+	if err != nil {
+		return nil, err
+	}
+
+	return result(c.client.ByCodes(ctx, &ByCodesRequest{Codes: codes, FieldMask: mask}))
+}
+
+// ByCapital returns the countries matching a partial capital name.
+func (c *Client) ByCapital(ctx context.Context, name string, fields ...string) ([]countries.Country, error) {
+	mask, err := fieldMask(fields)
+	if err != nil {
+		return nil, err
+	}
+
+	return result(c.client.ByCapital(ctx, &ByCapitalRequest{Capital: name, FieldMask: mask}))
+}
+
+// All returns every country.
+func (c *Client) All(ctx context.Context, fields ...string) ([]countries.Country, error) {
+	mask, err := fieldMask(fields)
+	if err != nil {
+		return nil, err
+	}
+
+	return result(c.client.All(ctx, &AllRequest{FieldMask: mask}))
+}
+
+// ByCurrency returns the countries using a currency.
+func (c *Client) ByCurrency(ctx context.Context, currency string, fields ...string) ([]countries.Country, error) {
+	mask, err := fieldMask(fields)
+	if err != nil {
+		return nil, err
+	}
+
+	return result(c.client.ByCurrency(ctx, &ByCurrencyRequest{Currency: currency, FieldMask: mask}))
+}
+
+// ByLanguage returns the countries speaking a language.
+func (c *Client) ByLanguage(ctx context.Context, language string, fields ...string) ([]countries.Country, error) {
+	mask, err := fieldMask(fields)
+	if err != nil {
+		return nil, err
+	}
+
+	return result(c.client.ByLanguage(ctx, &ByLanguageRequest{Language: language, FieldMask: mask}))
+}
+
+// ByCallingCode returns the countries with a calling code.
+func (c *Client) ByCallingCode(ctx context.Context, callingCode string, fields ...string) ([]countries.Country, error) {
+	mask, err := fieldMask(fields)
+	if err != nil {
+		return nil, err
+	}
+
+	return result(c.client.ByCallingCode(ctx, &ByCallingCodeRequest{CallingCode: callingCode, FieldMask: mask}))
+}
+
+// ByRegion returns the countries of a region.
+func (c *Client) ByRegion(ctx context.Context, region string, fields ...string) ([]countries.Country, error) {
+	mask, err := fieldMask(fields)
+	if err != nil {
+		return nil, err
+	}
+
+	return result(c.client.ByRegion(ctx, &ByRegionRequest{Region: region, FieldMask: mask}))
+}
+
+// ByRegionalBloc returns the members of a regional bloc.
+func (c *Client) ByRegionalBloc(ctx context.Context, regionalBloc string, fields ...string) ([]countries.Country, error) {
+	mask, err := fieldMask(fields)
+	if err != nil {
+		return nil, err
+	}
+
+	return result(c.client.ByRegionalBloc(ctx, &ByRegionalBlocRequest{RegionalBloc: regionalBloc, FieldMask: mask}))
+}
+
+// result converts the response of a call.
+func result(res *CountriesResponse, err error) ([]countries.Country, error) {
+	if status.Code(err) == codes.NotFound {
+		return nil, &countries.StatusError{StatusCode: http.StatusNotFound, Status: "404 Not Found"}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	c := make([]countries.Country, len(res.GetCountries()))
+	for i, p := range res.GetCountries() {
+		c[i] = fromProto(p)
+	}
+
+	return c, nil
+}
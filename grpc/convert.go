@@ -0,0 +1,97 @@
+package countriesgrpc
+
+import (
+	"github.com/georgesafta/countries"
+)
+
+// toProto converts a country to its protobuf message.
+func toProto(c countries.Country) *Country {
+	p := &Country{
+		Name:           c.Name,
+		Capital:        c.Capital,
+		TopLevelDomain: c.TopLevelDomain,
+		Alpha2Code:     c.Alpha2Code,
+		Alpha3Code:     c.Alpha3Code,
+		CallingCodes:   c.CallingCodes,
+		AltSpellings:   c.AltSpellings,
+		Region:         c.Region,
+		Subregion:      c.Subregion,
+		Population:     c.Population,
+		Latlng:         c.LatitudeLongitude,
+		Demonym:        c.Demonym,
+		Area:           c.Area,
+		Gini:           c.Gini,
+		Timezones:      c.Timezones,
+		Borders:        c.Borders,
+		NativeName:     c.NativeName,
+		NumericCode:    c.NumericCode,
+		Translations:   c.Translations,
+		Flag:           c.FlagURL,
+		Cioc:           c.Cioc,
+	}
+	for _, cur := range c.Currencies {
+		p.Currencies = append(p.Currencies, &Currency{Code: cur.Code, Name: cur.Name, Symbol: cur.Symbol})
+	}
+	for _, l := range c.Languages {
+		p.Languages = append(p.Languages, &Language{Iso639_1: l.Iso6391, Iso639_2: l.Iso6392, Name: l.Name, NativeName: l.NativeName})
+	}
+	for _, b := range c.RegionalBlocs {
+		p.RegionalBlocs = append(p.RegionalBlocs, &RegionalBloc{
+			Acronym:       b.Acronym,
+			Name:          b.Name,
+			OtherAcronyms: b.OtherAcronyms,
+			OtherNames:    b.OtherNames,
+		})
+	}
+
+	return p
+}
+
+// fromProto converts a protobuf message to a country. The other acronyms and
+// names of regional blocs are never nil, as in the API.
+func fromProto(p *Country) countries.Country {
+	c := countries.Country{
+		Name:              p.GetName(),
+		Capital:           p.GetCapital(),
+		TopLevelDomain:    p.GetTopLevelDomain(),
+		Alpha2Code:        p.GetAlpha2Code(),
+		Alpha3Code:        p.GetAlpha3Code(),
+		CallingCodes:      p.GetCallingCodes(),
+		AltSpellings:      p.GetAltSpellings(),
+		Region:            p.GetRegion(),
+		Subregion:         p.GetSubregion(),
+		Population:        p.GetPopulation(),
+		LatitudeLongitude: p.GetLatlng(),
+		Demonym:           p.GetDemonym(),
+		Area:              p.GetArea(),
+		Gini:              p.GetGini(),
+		Timezones:         p.GetTimezones(),
+		Borders:           p.GetBorders(),
+		NativeName:        p.GetNativeName(),
+		NumericCode:       p.GetNumericCode(),
+		Translations:      p.GetTranslations(),
+		FlagURL:           p.GetFlag(),
+		Cioc:              p.GetCioc(),
+	}
+	for _, cur := range p.GetCurrencies() {
+		c.Currencies = append(c.Currencies, countries.Currency{Code: cur.GetCode(), Name: cur.GetName(), Symbol: cur.GetSymbol()})
+	}
+	for _, l := range p.GetLanguages() {
+		c.Languages = append(c.Languages, countries.Language{
+			Iso6391:    l.GetIso639_1(),
+			Iso6392:    l.GetIso639_2(),
+			Name:       l.GetName(),
+			NativeName: l.GetNativeName(),
+		})
+	}
+	for _, b := range p.GetRegionalBlocs() {
+		c.RegionalBlocs = append(c.RegionalBlocs, countries.RegionalBloc{
+			Acronym:       b.GetAcronym(),
+			Name:          b.GetName(),
+			OtherAcronyms: append([]string{}, b.GetOtherAcronyms()...),
+			OtherNames:    append([]string{}, b.GetOtherNames()...),
+		})
+	}
+
+	return c
+}
@@ -0,0 +1,493 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: countries.proto
+
+package countriesgrpc
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	Countries_All_FullMethodName            = "/countries.v2.Countries/All"
+	Countries_ByName_FullMethodName         = "/countries.v2.Countries/ByName"
+	Countries_ByCode_FullMethodName         = "/countries.v2.Countries/ByCode"
+	Countries_ByCodes_FullMethodName        = "/countries.v2.Countries/ByCodes"
+	Countries_ByCapital_FullMethodName      = "/countries.v2.Countries/ByCapital"
+	Countries_ByCurrency_FullMethodName     = "/countries.v2.Countries/ByCurrency"
+	Countries_ByLanguage_FullMethodName     = "/countries.v2.Countries/ByLanguage"
+	Countries_ByCallingCode_FullMethodName  = "/countries.v2.Countries/ByCallingCode"
+	Countries_ByRegion_FullMethodName       = "/countries.v2.Countries/ByRegion"
+	Countries_ByRegionalBloc_FullMethodName = "/countries.v2.Countries/ByRegionalBloc"
+)
+
+// CountriesClient is the client API for Countries service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Countries exposes the lookups of the v2 countries API.
+// Every lookup takes a field mask of the Country fields to return, replacing
+// the fields filter of the API. An empty mask returns every field.
+type CountriesClient interface {
+	// All returns every country.
+	All(ctx context.Context, in *AllRequest, opts ...grpc.CallOption) (*CountriesResponse, error)
+	// ByName returns the countries matching a partial name or native name,
+	// or the full name when full_text is set.
+	ByName(ctx context.Context, in *ByNameRequest, opts ...grpc.CallOption) (*CountriesResponse, error)
+	// ByCode returns the country with an alpha-2 or alpha-3 code.
+	ByCode(ctx context.Context, in *ByCodeRequest, opts ...grpc.CallOption) (*CountriesResponse, error)
+	// ByCodes returns the countries with the alpha-2 or alpha-3 codes.
+	ByCodes(ctx context.Context, in *ByCodesRequest, opts ...grpc.CallOption) (*CountriesResponse, error)
+	// ByCapital returns the countries matching a partial capital name.
+	ByCapital(ctx context.Context, in *ByCapitalRequest, opts ...grpc.CallOption) (*CountriesResponse, error)
+	// ByCurrency returns the countries using an ISO 4217 currency code.
+	ByCurrency(ctx context.Context, in *ByCurrencyRequest, opts ...grpc.CallOption) (*CountriesResponse, error)
+	// ByLanguage returns the countries speaking an ISO 639-1 or 639-2 language.
+	ByLanguage(ctx context.Context, in *ByLanguageRequest, opts ...grpc.CallOption) (*CountriesResponse, error)
+	// ByCallingCode returns the countries with a calling code.
+	ByCallingCode(ctx context.Context, in *ByCallingCodeRequest, opts ...grpc.CallOption) (*CountriesResponse, error)
+	// ByRegion returns the countries of a region.
+	ByRegion(ctx context.Context, in *ByRegionRequest, opts ...grpc.CallOption) (*CountriesResponse, error)
+	// ByRegionalBloc returns the members of a regional bloc.
+	ByRegionalBloc(ctx context.Context, in *ByRegionalBlocRequest, opts ...grpc.CallOption) (*CountriesResponse, error)
+}
+
+type countriesClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCountriesClient(cc grpc.ClientConnInterface) CountriesClient {
+	return &countriesClient{cc}
+}
+
+func (c *countriesClient) All(ctx context.Context, in *AllRequest, opts ...grpc.CallOption) (*CountriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CountriesResponse)
+	err := c.cc.Invoke(ctx, Countries_All_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *countriesClient) ByName(ctx context.Context, in *ByNameRequest, opts ...grpc.CallOption) (*CountriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CountriesResponse)
+	err := c.cc.Invoke(ctx, Countries_ByName_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *countriesClient) ByCode(ctx context.Context, in *ByCodeRequest, opts ...grpc.CallOption) (*CountriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CountriesResponse)
+	err := c.cc.Invoke(ctx, Countries_ByCode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *countriesClient) ByCodes(ctx context.Context, in *ByCodesRequest, opts ...grpc.CallOption) (*CountriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CountriesResponse)
+	err := c.cc.Invoke(ctx, Countries_ByCodes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *countriesClient) ByCapital(ctx context.Context, in *ByCapitalRequest, opts ...grpc.CallOption) (*CountriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CountriesResponse)
+	err := c.cc.Invoke(ctx, Countries_ByCapital_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *countriesClient) ByCurrency(ctx context.Context, in *ByCurrencyRequest, opts ...grpc.CallOption) (*CountriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CountriesResponse)
+	err := c.cc.Invoke(ctx, Countries_ByCurrency_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *countriesClient) ByLanguage(ctx context.Context, in *ByLanguageRequest, opts ...grpc.CallOption) (*CountriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CountriesResponse)
+	err := c.cc.Invoke(ctx, Countries_ByLanguage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *countriesClient) ByCallingCode(ctx context.Context, in *ByCallingCodeRequest, opts ...grpc.CallOption) (*CountriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CountriesResponse)
+	err := c.cc.Invoke(ctx, Countries_ByCallingCode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *countriesClient) ByRegion(ctx context.Context, in *ByRegionRequest, opts ...grpc.CallOption) (*CountriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CountriesResponse)
+	err := c.cc.Invoke(ctx, Countries_ByRegion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *countriesClient) ByRegionalBloc(ctx context.Context, in *ByRegionalBlocRequest, opts ...grpc.CallOption) (*CountriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CountriesResponse)
+	err := c.cc.Invoke(ctx, Countries_ByRegionalBloc_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CountriesServer is the server API for Countries service.
+// All implementations must embed UnimplementedCountriesServer
+// for forward compatibility.
+//
+// Countries exposes the lookups of the v2 countries API.
+// Every lookup takes a field mask of the Country fields to return, replacing
+// the fields filter of the API. An empty mask returns every field.
+type CountriesServer interface {
+	// All returns every country.
+	All(context.Context, *AllRequest) (*CountriesResponse, error)
+	// ByName returns the countries matching a partial name or native name,
+	// or the full name when full_text is set.
+	ByName(context.Context, *ByNameRequest) (*CountriesResponse, error)
+	// ByCode returns the country with an alpha-2 or alpha-3 code.
+	ByCode(context.Context, *ByCodeRequest) (*CountriesResponse, error)
+	// ByCodes returns the countries with the alpha-2 or alpha-3 codes.
+	ByCodes(context.Context, *ByCodesRequest) (*CountriesResponse, error)
+	// ByCapital returns the countries matching a partial capital name.
+	ByCapital(context.Context, *ByCapitalRequest) (*CountriesResponse, error)
+	// ByCurrency returns the countries using an ISO 4217 currency code.
+	ByCurrency(context.Context, *ByCurrencyRequest) (*CountriesResponse, error)
+	// ByLanguage returns the countries speaking an ISO 639-1 or 639-2 language.
+	ByLanguage(context.Context, *ByLanguageRequest) (*CountriesResponse, error)
+	// ByCallingCode returns the countries with a calling code.
+	ByCallingCode(context.Context, *ByCallingCodeRequest) (*CountriesResponse, error)
+	// ByRegion returns the countries of a region.
+	ByRegion(context.Context, *ByRegionRequest) (*CountriesResponse, error)
+	// ByRegionalBloc returns the members of a regional bloc.
+	ByRegionalBloc(context.Context, *ByRegionalBlocRequest) (*CountriesResponse, error)
+	mustEmbedUnimplementedCountriesServer()
+}
+
+// UnimplementedCountriesServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCountriesServer struct{}
+
+func (UnimplementedCountriesServer) All(context.Context, *AllRequest) (*CountriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method All not implemented")
+}
+func (UnimplementedCountriesServer) ByName(context.Context, *ByNameRequest) (*CountriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ByName not implemented")
+}
+func (UnimplementedCountriesServer) ByCode(context.Context, *ByCodeRequest) (*CountriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ByCode not implemented")
+}
+func (UnimplementedCountriesServer) ByCodes(context.Context, *ByCodesRequest) (*CountriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ByCodes not implemented")
+}
+func (UnimplementedCountriesServer) ByCapital(context.Context, *ByCapitalRequest) (*CountriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ByCapital not implemented")
+}
+func (UnimplementedCountriesServer) ByCurrency(context.Context, *ByCurrencyRequest) (*CountriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ByCurrency not implemented")
+}
+func (UnimplementedCountriesServer) ByLanguage(context.Context, *ByLanguageRequest) (*CountriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ByLanguage not implemented")
+}
+func (UnimplementedCountriesServer) ByCallingCode(context.Context, *ByCallingCodeRequest) (*CountriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ByCallingCode not implemented")
+}
+func (UnimplementedCountriesServer) ByRegion(context.Context, *ByRegionRequest) (*CountriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ByRegion not implemented")
+}
+func (UnimplementedCountriesServer) ByRegionalBloc(context.Context, *ByRegionalBlocRequest) (*CountriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ByRegionalBloc not implemented")
+}
+func (UnimplementedCountriesServer) mustEmbedUnimplementedCountriesServer() {}
+func (UnimplementedCountriesServer) testEmbeddedByValue()                   {}
+
+// UnsafeCountriesServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CountriesServer will
+// result in compilation errors.
+type UnsafeCountriesServer interface {
+	mustEmbedUnimplementedCountriesServer()
+}
+
+func RegisterCountriesServer(s grpc.ServiceRegistrar, srv CountriesServer) {
+	// If the following call pancis, it indicates UnimplementedCountriesServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Countries_ServiceDesc, srv)
+}
+
+func _Countries_All_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AllRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CountriesServer).All(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Countries_All_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CountriesServer).All(ctx, req.(*AllRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Countries_ByName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ByNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CountriesServer).ByName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Countries_ByName_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CountriesServer).ByName(ctx, req.(*ByNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Countries_ByCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ByCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CountriesServer).ByCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Countries_ByCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CountriesServer).ByCode(ctx, req.(*ByCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Countries_ByCodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ByCodesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CountriesServer).ByCodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Countries_ByCodes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CountriesServer).ByCodes(ctx, req.(*ByCodesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Countries_ByCapital_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ByCapitalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CountriesServer).ByCapital(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Countries_ByCapital_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CountriesServer).ByCapital(ctx, req.(*ByCapitalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Countries_ByCurrency_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ByCurrencyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CountriesServer).ByCurrency(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Countries_ByCurrency_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CountriesServer).ByCurrency(ctx, req.(*ByCurrencyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Countries_ByLanguage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ByLanguageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CountriesServer).ByLanguage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Countries_ByLanguage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CountriesServer).ByLanguage(ctx, req.(*ByLanguageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Countries_ByCallingCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ByCallingCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CountriesServer).ByCallingCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Countries_ByCallingCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CountriesServer).ByCallingCode(ctx, req.(*ByCallingCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Countries_ByRegion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ByRegionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CountriesServer).ByRegion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Countries_ByRegion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CountriesServer).ByRegion(ctx, req.(*ByRegionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Countries_ByRegionalBloc_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ByRegionalBlocRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CountriesServer).ByRegionalBloc(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Countries_ByRegionalBloc_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CountriesServer).ByRegionalBloc(ctx, req.(*ByRegionalBlocRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Countries_ServiceDesc is the grpc.ServiceDesc for Countries service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Countries_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "countries.v2.Countries",
+	HandlerType: (*CountriesServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "All",
+			Handler:    _Countries_All_Handler,
+		},
+		{
+			MethodName: "ByName",
+			Handler:    _Countries_ByName_Handler,
+		},
+		{
+			MethodName: "ByCode",
+			Handler:    _Countries_ByCode_Handler,
+		},
+		{
+			MethodName: "ByCodes",
+			Handler:    _Countries_ByCodes_Handler,
+		},
+		{
+			MethodName: "ByCapital",
+			Handler:    _Countries_ByCapital_Handler,
+		},
+		{
+			MethodName: "ByCurrency",
+			Handler:    _Countries_ByCurrency_Handler,
+		},
+		{
+			MethodName: "ByLanguage",
+			Handler:    _Countries_ByLanguage_Handler,
+		},
+		{
+			MethodName: "ByCallingCode",
+			Handler:    _Countries_ByCallingCode_Handler,
+		},
+		{
+			MethodName: "ByRegion",
+			Handler:    _Countries_ByRegion_Handler,
+		},
+		{
+			MethodName: "ByRegionalBloc",
+			Handler:    _Countries_ByRegionalBloc_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "countries.proto",
+}
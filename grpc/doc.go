@@ -0,0 +1,14 @@
+// Package countriesgrpc serves the lookups of the countries API over gRPC.
+//
+// The Server exposes the lookups of a Lookup, such as a countries.HTTPClient
+// pointed at the API or at a countries.Server, and the Client calls them with
+// the same arguments as the HTTPClient. Field masks replace the fields filter:
+// the Client turns API field names into a mask, and the Server returns only
+// the masked fields.
+//
+// The package lives in its own module so the countries module keeps no
+// dependencies. Regenerate the protobuf code after changing countries.proto:
+//
+//	protoc --go_out=. --go_opt=paths=source_relative \
+//		--go-grpc_out=. --go-grpc_opt=paths=source_relative countries.proto
+package countriesgrpc
@@ -0,0 +1,64 @@
+package countriesgrpc
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// fieldMask returns the mask of the given API field names, e.g. alpha2Code,
+// or nil without fields.
+func fieldMask(fields []string) (*fieldmaskpb.FieldMask, error) {
+	if len(fields) == 0 {
+		return nil, nil
+	}
+
+	md := (&Country{}).ProtoReflect().Descriptor()
+	mask := &fieldmaskpb.FieldMask{}
+	for _, field := range fields {
+		fd := md.Fields().ByJSONName(field)
+		if fd == nil {
+			return nil, fmt.Errorf("Unknown country field %q", field)
+		}
+		mask.Paths = append(mask.Paths, string(fd.Name()))
+	}
+
+	return mask, nil
+}
+
+// apiFields returns the API field names of the fields of the mask.
+func apiFields(mask *fieldmaskpb.FieldMask) []string {
+	fields := (&Country{}).ProtoReflect().Descriptor().Fields()
+	var names []string
+	for _, path := range mask.GetPaths() {
+		names = append(names, fields.ByName(protoreflect.Name(path)).JSONName())
+	}
+
+	return names
+}
+
+// prune clears the fields of the country outside of the mask.
+func prune(c *Country, mask *fieldmaskpb.FieldMask) {
+	m := c.ProtoReflect()
+	var cleared []protoreflect.FieldDescriptor
+	m.Range(func(fd protoreflect.FieldDescriptor, _ protoreflect.Value) bool {
+		if !contains(mask.GetPaths(), string(fd.Name())) {
+			cleared = append(cleared, fd)
+		}
+		return true
+	})
+	for _, fd := range cleared {
+		m.Clear(fd)
+	}
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
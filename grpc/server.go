@@ -0,0 +1,186 @@
+package countriesgrpc
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/georgesafta/countries"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// Lookup is the country lookups served by the Server,
+// as implemented by countries.HTTPClient.
+type Lookup interface {
+	ByName(name string, fields ...string) ([]countries.Country, error)
+	ByFullName(name string, fields ...string) ([]countries.Country, error)
+	ByCode(code string, fields ...string) ([]countries.Country, error)
+	ByCodes(codes []string, fields ...string) ([]countries.Country, error)
+	ByCapital(name string, fields ...string) ([]countries.Country, error)
+	All(fields ...string) ([]countries.Country, error)
+	ByCurrency(currency string, fields ...string) ([]countries.Country, error)
+	ByLanguage(language string, fields ...string) ([]countries.Country, error)
+	ByCallingCode(callingCode string, fields ...string) ([]countries.Country, error)
+	ByRegion(region string, fields ...string) ([]countries.Country, error)
+	ByRegionalBloc(regionalBloc string, fields ...string) ([]countries.Country, error)
+}
+
+// ContextLookup is a Lookup whose calls can be bound to a context.
+type ContextLookup interface {
+	Lookup
+	WithContext(ctx context.Context) Lookup
+}
+
+// Server implements the Countries gRPC service over a Lookup.
+//
+// Lookups are bound to the context of the request when the Lookup is a
+// countries.HTTPClient or a ContextLookup, so they are cancelled with it.
+//
+// A lookup answered with a 404 by the API is a NotFound error, a 400 is
+// InvalidArgument and other 4xx are FailedPrecondition. A cancelled or timed
+// out request is Canceled or DeadlineExceeded, and any other failure of the
+// lookup is Unavailable. Invalid field masks and empty lists of codes are
+// InvalidArgument.
+type Server struct {
+	UnimplementedCountriesServer
+	lookup Lookup
+}
+
+// NewServer returns a new Server answering with the given lookup.
+func NewServer(lookup Lookup) *Server {
+	return &Server{lookup: lookup}
+}
+
+// All returns every country.
+func (s *Server) All(ctx context.Context, req *AllRequest) (*CountriesResponse, error) {
+	return s.serve(ctx, req.GetFieldMask(), func(lookup Lookup, fields []string) ([]countries.Country, error) {
+		return lookup.All(fields...)
+	})
+}
+
+// ByName returns the countries matching a partial name, or the full name.
+func (s *Server) ByName(ctx context.Context, req *ByNameRequest) (*CountriesResponse, error) {
+	return s.serve(ctx, req.GetFieldMask(), func(lookup Lookup, fields []string) ([]countries.Country, error) {
+		if req.GetFullText() {
+			return lookup.ByFullName(req.GetName(), fields...)
+		}
+		return lookup.ByName(req.GetName(), fields...)
+	})
+}
+
+// ByCode returns the country with an alpha-2 or alpha-3 code.
+func (s *Server) ByCode(ctx context.Context, req *ByCodeRequest) (*CountriesResponse, error) {
+	return s.serve(ctx, req.GetFieldMask(), func(lookup Lookup, fields []string) ([]countries.Country, error) {
+		return lookup.ByCode(req.GetCode(), fields...)
+	})
+}
+
+// ByCodes returns the countries with the alpha-2 or alpha-3 codes.
+func (s *Server) ByCodes(ctx context.Context, req *ByCodesRequest) (*CountriesResponse, error) {
+	if len(req.GetCodes()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "Empty list of codes")
+	}
+	return s.serve(ctx, req.GetFieldMask(), func(lookup Lookup, fields []string) ([]countries.Country, error) {
+		return lookup.ByCodes(req.GetCodes(), fields...)
+	})
+}
+
+// ByCapital returns the countries matching a partial capital name.
+func (s *Server) ByCapital(ctx context.Context, req *ByCapitalRequest) (*CountriesResponse, error) {
+	return s.serve(ctx, req.GetFieldMask(), func(lookup Lookup, fields []string) ([]countries.Country, error) {
+		return lookup.ByCapital(req.GetCapital(), fields...)
+	})
+}
+
+// ByCurrency returns the countries using a currency.
+func (s *Server) ByCurrency(ctx context.Context, req *ByCurrencyRequest) (*CountriesResponse, error) {
+	return s.serve(ctx, req.GetFieldMask(), func(lookup Lookup, fields []string) ([]countries.Country, error) {
+		return lookup.ByCurrency(req.GetCurrency(), fields...)
+	})
+}
+
+// ByLanguage returns the countries speaking a language.
+func (s *Server) ByLanguage(ctx context.Context, req *ByLanguageRequest) (*CountriesResponse, error) {
+	return s.serve(ctx, req.GetFieldMask(), func(lookup Lookup, fields []string) ([]countries.Country, error) {
+		return lookup.ByLanguage(req.GetLanguage(), fields...)
+	})
+}
+
+// ByCallingCode returns the countries with a calling code.
+func (s *Server) ByCallingCode(ctx context.Context, req *ByCallingCodeRequest) (*CountriesResponse, error) {
+	return s.serve(ctx, req.GetFieldMask(), func(lookup Lookup, fields []string) ([]countries.Country, error) {
+		return lookup.ByCallingCode(req.GetCallingCode(), fields...)
+	})
+}
+
+// ByRegion returns the countries of a region.
+func (s *Server) ByRegion(ctx context.Context, req *ByRegionRequest) (*CountriesResponse, error) {
+	return s.serve(ctx, req.GetFieldMask(), func(lookup Lookup, fields []string) ([]countries.Country, error) {
+		return lookup.ByRegion(req.GetRegion(), fields...)
+	})
+}
+
+// ByRegionalBloc returns the members of a regional bloc.
+func (s *Server) ByRegionalBloc(ctx context.Context, req *ByRegionalBlocRequest) (*CountriesResponse, error) {
+	return s.serve(ctx, req.GetFieldMask(), func(lookup Lookup, fields []string) ([]countries.Country, error) {
+		return lookup.ByRegionalBloc(req.GetRegionalBloc(), fields...)
+	})
+}
+
+// serve runs the lookup bound to ctx with the fields of the mask, and returns
+// the countries with only the masked fields. Message fields of a Country are
+// repeated, so masks can't select their subfields.
+func (s *Server) serve(ctx context.Context, mask *fieldmaskpb.FieldMask, lookup func(lookup Lookup, fields []string) ([]countries.Country, error)) (*CountriesResponse, error) {
+	if mask != nil && !mask.IsValid(&Country{}) {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid field mask %v", mask.GetPaths())
+	}
+
+	result, err := lookup(s.withContext(ctx), apiFields(mask))
+	if err != nil {
+		return nil, lookupError(ctx, err)
+	}
+
+	res := &CountriesResponse{Countries: make([]*Country, len(result))}
+	for i, c := range result {
+		res.Countries[i] = toProto(c)
+		if len(mask.GetPaths()) > 0 {
+			prune(res.Countries[i], mask)
+		}
+	}
+
+	return res, nil
+}
+
+// withContext returns the lookup bound to ctx, when it supports it.
+func (s *Server) withContext(ctx context.Context) Lookup {
+	switch l := s.lookup.(type) {
+	case *countries.HTTPClient:
+		return l.WithContext(ctx)
+	case ContextLookup:
+		return l.WithContext(ctx)
+	}
+
+	return s.lookup
+}
+
+// lookupError converts an error of the lookup to a gRPC status error.
+func lookupError(ctx context.Context, err error) error {
+	if ctx.Err() != nil {
+		return status.FromContextError(ctx.Err()).Err()
+	}
+	var statusErr *countries.StatusError
+	if errors.As(err, &statusErr) {
+		switch {
+		case statusErr.StatusCode == http.StatusNotFound:
+			return status.Error(codes.NotFound, err.Error())
+		case statusErr.StatusCode == http.StatusBadRequest:
+			return status.Error(codes.InvalidArgument, err.Error())
+		case statusErr.StatusCode > http.StatusBadRequest && statusErr.StatusCode < http.StatusInternalServerError:
+			return status.Error(codes.FailedPrecondition, err.Error())
+		}
+	}
+
+	return status.Error(codes.Unavailable, err.Error())
+}
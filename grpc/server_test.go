@@ -0,0 +1,204 @@
+package countriesgrpc_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/georgesafta/countries"
+	countriesgrpc "github.com/georgesafta/countries/grpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+const datasetMockPath = "../mock/dataset.json"
+
+// serve starts a Server over bufconn, backed by an HTTPClient calling a
+// countries.Server, and returns a connection to it.
+func serve(t *testing.T) (*grpc.ClientConn, *countries.HTTPClient) {
+	data, err := ioutil.ReadFile(datasetMockPath)
+	if err != nil {
+		t.Fatalf("Cannot read dataset: %v", err)
+	}
+	var dataset []countries.Country
+	if err := json.Unmarshal(data, &dataset); err != nil {
+		t.Fatalf("Cannot decode dataset: %v", err)
+	}
+	api := httptest.NewServer(countries.NewServer(dataset))
+	t.Cleanup(api.Close)
+	lookup := countries.NewHTTPClient(api.URL)
+
+	lis := bufconn.Listen(1 << 20)
+	s := grpc.NewServer()
+	countriesgrpc.RegisterCountriesServer(s, countriesgrpc.NewServer(lookup))
+	go s.Serve(lis)
+	t.Cleanup(s.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+
+	return conn, lookup
+}
+
+func marshal(t *testing.T, c []countries.Country) string {
+	data, err := json.Marshal(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return string(data)
+}
+
+func TestLookups(t *testing.T) {
+	conn, lookup := serve(t)
+	client := countriesgrpc.NewClient(conn)
+	ctx := context.Background()
+
+	expected, err := lookup.All()
+	if err != nil {
+		t.Fatal(err)
+	}
+	all, err := client.All(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if marshal(t, all) != marshal(t, expected) {
+		t.Fatalf("Expected %s, got %s", marshal(t, expected), marshal(t, all))
+	}
+
+	for _, test := range []struct {
+		call     func() ([]countries.Country, error)
+		expected []string
+	}{
+		{func() ([]countries.Country, error) { return client.ByName(ctx, "united") }, []string{"USA", "GBR"}},
+		{func() ([]countries.Country, error) { return client.ByFullName(ctx, "peru") }, []string{"PER"}},
+		{func() ([]countries.Country, error) { return client.ByCode(ctx, "co") }, []string{"COL"}},
+		{func() ([]countries.Country, error) { return client.ByCodes(ctx, []string{"FR", "deu"}) }, []string{"FRA", "DEU"}},
+		{func() ([]countries.Country, error) { return client.ByCapital(ctx, "lima") }, []string{"PER"}},
+		{func() ([]countries.Country, error) { return client.ByCurrency(ctx, "chf") }, []string{"CHE"}},
+		{func() ([]countries.Country, error) { return client.ByLanguage(ctx, "ko") }, []string{"KOR"}},
+		{func() ([]countries.Country, error) { return client.ByCallingCode(ctx, "57") }, []string{"COL"}},
+		{func() ([]countries.Country, error) { return client.ByRegion(ctx, "oceania") }, []string{"AUS", "TUV"}},
+		{func() ([]countries.Country, error) { return client.ByRegionalBloc(ctx, "pa") }, []string{"COL", "PER", "MEX"}},
+	} {
+		result, err := test.call()
+		if err != nil {
+			t.Fatal(err)
+		}
+		var codes []string
+		for _, c := range result {
+			codes = append(codes, c.Alpha3Code)
+		}
+		if len(codes) != len(test.expected) {
+			t.Fatalf("Expected %v, got %v", test.expected, codes)
+		}
+		for i := range codes {
+			if codes[i] != test.expected[i] {
+				t.Fatalf("Expected %v, got %v", test.expected, codes)
+			}
+		}
+	}
+}
+
+func TestFieldMask(t *testing.T) {
+	conn, _ := serve(t)
+	client := countriesgrpc.NewClient(conn)
+
+	result, err := client.ByCode(context.Background(), "COL", "name", "currencies", "latlng")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := `[{"name":"Colombia","alpha2Code":"","population":0,"latlng":[4,-72],"currencies":[{"code":"COP","name":"Colombian peso","symbol":"$"}]}]`
+	if marshal(t, result) != expected {
+		t.Fatalf("Expected %s, got %s", expected, marshal(t, result))
+	}
+
+	if _, err := client.All(context.Background(), "currencies.code"); err == nil {
+		t.Fatal("Expected an error for an unknown field")
+	}
+
+	_, err = countriesgrpc.NewCountriesClient(conn).All(context.Background(), &countriesgrpc.AllRequest{
+		FieldMask: &fieldmaskpb.FieldMask{Paths: []string{"currencies.code"}},
+	})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("Expected an InvalidArgument error, got %v", err)
+	}
+}
+
+func TestErrors(t *testing.T) {
+	conn, lookup := serve(t)
+	client := countriesgrpc.NewClient(conn)
+
+	_, err := client.ByName(context.Background(), "atlantis")
+	var statusErr *countries.StatusError
+	if !errors.As(err, &statusErr) || statusErr.StatusCode != http.StatusNotFound {
+		t.Fatalf("Expected a not found StatusError, got %v", err)
+	}
+
+	lookup.Client.Transport = roundTripperFunc(func(*http.Request) (*http.Response, error) {
+		return nil, errors.New("connection refused")
+	})
+	if _, err := client.All(context.Background()); status.Code(err) != codes.Unavailable {
+		t.Fatalf("Expected an Unavailable error, got %v", err)
+	}
+
+	if _, err := client.ByCodes(context.Background(), nil); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("Expected an InvalidArgument error for no codes, got %v", err)
+	}
+
+	// Requests rejected by the API are not retryable.
+	for code, expected := range map[int]codes.Code{http.StatusBadRequest: codes.InvalidArgument, http.StatusForbidden: codes.FailedPrecondition} {
+		code := code
+		lookup.Client.Transport = roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: code, Status: http.StatusText(code), Body: ioutil.NopCloser(strings.NewReader("")), Request: r}, nil
+		})
+		if _, err := client.ByCode(context.Background(), "col"); status.Code(err) != expected {
+			t.Fatalf("Expected %s for %d, got %v", expected, code, err)
+		}
+	}
+}
+
+func TestContext(t *testing.T) {
+	conn, lookup := serve(t)
+	client := countriesgrpc.NewClient(conn)
+
+	// The deadline of the request reaches the API call.
+	cancelled := make(chan struct{})
+	lookup.Client.Transport = roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		<-r.Context().Done()
+		close(cancelled)
+		return nil, r.Context().Err()
+	})
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if _, err := client.ByRegion(ctx, "europe"); status.Code(err) != codes.DeadlineExceeded {
+		t.Fatalf("Expected a DeadlineExceeded error, got %v", err)
+	}
+	select {
+	case <-cancelled:
+	case <-time.After(time.Second):
+		t.Fatal("Expected the API call to be cancelled with the request")
+	}
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
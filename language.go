@@ -0,0 +1,152 @@
+package countries
+
+import (
+	"sort"
+	"strings"
+)
+
+// LanguageInfo is a language of the catalog, with the countries listing it.
+// Population is the estimated reach of the language, the sum of the
+// population of those countries.
+type LanguageInfo struct {
+	Iso6391    string
+	Iso6392    string
+	Name       string
+	NativeName string
+	Countries  []string
+	Population int64
+}
+
+// LanguageCatalog indexes the languages of a set of countries.
+// Languages are identified by ISO 639-2 code, or by ISO 639-1 code and
+// then by name for those lacking one.
+type LanguageCatalog struct {
+	languages map[string]*LanguageInfo
+	aliases   map[string]string
+	countries map[string]Country
+}
+
+// NewLanguageCatalog returns a new LanguageCatalog built from the Languages of the given countries.
+func NewLanguageCatalog(countries []Country) *LanguageCatalog {
+	catalog := &LanguageCatalog{
+		languages: map[string]*LanguageInfo{},
+		aliases:   map[string]string{},
+		countries: make(map[string]Country, len(countries)),
+	}
+	for _, c := range countries {
+		catalog.countries[strings.ToUpper(c.Alpha3Code)] = c
+		for _, l := range c.Languages {
+			key := catalog.key(l)
+			if key == "" {
+				continue
+			}
+			info, ok := catalog.languages[key]
+			if !ok {
+				info = &LanguageInfo{}
+				catalog.languages[key] = info
+			}
+			info.merge(l)
+			if !containsCode(info.Countries, c.Alpha3Code) {
+				info.Countries = append(info.Countries, c.Alpha3Code)
+				info.Population += int64(c.Population)
+			}
+			for _, alias := range []string{l.Iso6391, l.Iso6392, l.Name, l.NativeName} {
+				if a := fold(alias); a != "" {
+					if _, taken := catalog.aliases[a]; !taken {
+						catalog.aliases[a] = key
+					}
+				}
+			}
+		}
+	}
+	for _, info := range catalog.languages {
+		sort.Strings(info.Countries)
+	}
+
+	return catalog
+}
+
+// key returns the identifier of the language, reusing the entry already
+// registered under one of its codes, or under its name when it has none.
+func (c *LanguageCatalog) key(l Language) string {
+	ids := []string{l.Iso6392, l.Iso6391}
+	if l.Iso6392 == "" && l.Iso6391 == "" {
+		ids = []string{l.Name}
+	}
+	for _, id := range ids {
+		if key, ok := c.aliases[fold(id)]; ok && id != "" {
+			return key
+		}
+	}
+	for _, id := range []string{l.Iso6392, l.Iso6391, l.Name} {
+		if id != "" {
+			return fold(id)
+		}
+	}
+
+	return ""
+}
+
+func (info *LanguageInfo) merge(l Language) {
+	if info.Iso6391 == "" {
+		info.Iso6391 = l.Iso6391
+	}
+	if info.Iso6392 == "" {
+		info.Iso6392 = l.Iso6392
+	}
+	if info.Name == "" {
+		info.Name = l.Name
+	}
+	if info.NativeName == "" {
+		info.NativeName = l.NativeName
+	}
+}
+
+// Language returns the language identified by its ISO 639-1 code, ISO 639-2 code,
+// english name or native name. Matching ignores case and accents.
+func (c *LanguageCatalog) Language(id string) (LanguageInfo, bool) {
+	info, ok := c.languages[c.aliases[fold(id)]]
+	if !ok {
+		return LanguageInfo{}, false
+	}
+
+	return *info, true
+}
+
+// Languages returns every language of the catalog, sorted by name.
+func (c *LanguageCatalog) Languages() []LanguageInfo {
+	languages := make([]LanguageInfo, 0, len(c.languages))
+	for _, info := range c.languages {
+		languages = append(languages, *info)
+	}
+	sort.Slice(languages, func(i, j int) bool {
+		return languages[i].Name < languages[j].Name
+	})
+
+	return languages
+}
+
+// ByReach returns every language of the catalog, the largest estimated reach first.
+func (c *LanguageCatalog) ByReach() []LanguageInfo {
+	languages := c.Languages()
+	sort.SliceStable(languages, func(i, j int) bool {
+		return languages[i].Population > languages[j].Population
+	})
+
+	return languages
+}
+
+// Countries returns the countries listing the language, sorted by code.
+func (c *LanguageCatalog) Countries(id string) []Country {
+	info, ok := c.languages[c.aliases[fold(id)]]
+	if !ok {
+		return nil
+	}
+
+	countries := make([]Country, len(info.Countries))
+	for i, code := range info.Countries {
+		countries[i] = c.countries[strings.ToUpper(code)]
+	}
+
+	return countries
+}
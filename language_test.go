@@ -0,0 +1,73 @@
+package countries_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/georgesafta/countries"
+)
+
+func TestLanguageCatalogLookup(t *testing.T) {
+	catalog := countries.NewLanguageCatalog(loadDataset(t))
+
+	for _, id := range []string{"es", "SPA", "spanish", "Español", "espanol"} {
+		info, ok := catalog.Language(id)
+		if !ok || info.Iso6391 != "es" || info.Iso6392 != "spa" || info.Name != "Spanish" {
+			t.Fatalf("Expected Spanish for %q, got %v", id, info)
+		}
+	}
+	if _, ok := catalog.Language("tlh"); ok {
+		t.Fatal("Expected unknown language")
+	}
+}
+
+func TestLanguageCatalogCountries(t *testing.T) {
+	data := loadDataset(t)
+	catalog := countries.NewLanguageCatalog(data)
+
+	var codes []string
+	var population int64
+	for _, c := range catalog.Countries("qu") {
+		codes = append(codes, c.Alpha3Code)
+		population += int64(c.Population)
+	}
+	if !reflect.DeepEqual([]string{"BOL", "PER"}, codes) {
+		t.Fatalf("Expected Quechua countries BOL and PER, got %v", codes)
+	}
+
+	quechua, _ := catalog.Language("que")
+	if quechua.Population != population || population != 42473759 {
+		t.Fatalf("Expected Quechua reach %d, got %d", population, quechua.Population)
+	}
+}
+
+func TestLanguageCatalogByReach(t *testing.T) {
+	catalog := countries.NewLanguageCatalog(loadDataset(t))
+
+	reach := catalog.ByReach()
+	if reach[0].Name != "English" || reach[1].Name != "Chinese" {
+		t.Fatalf("Expected English then Chinese, got %s then %s", reach[0].Name, reach[1].Name)
+	}
+	if len(reach) != len(catalog.Languages()) {
+		t.Fatal("Expected every language to be ranked")
+	}
+}
+
+func TestLanguageCatalogWithout6391(t *testing.T) {
+	catalog := countries.NewLanguageCatalog([]countries.Country{
+		{Alpha3Code: "AAA", Population: 10, Languages: []countries.Language{{Iso6392: "fil", Name: "Filipino"}}},
+		{Alpha3Code: "BBB", Population: 5, Languages: []countries.Language{{Name: "Filipino"}, {Iso6391: "tl", Name: "Tagalog"}}},
+		{Alpha3Code: "CCC", Population: 1, Languages: []countries.Language{{Name: "Unwritten"}}},
+	})
+
+	filipino, ok := catalog.Language("fil")
+	if !ok || filipino.Iso6391 != "" || filipino.Population != 15 || !reflect.DeepEqual([]string{"AAA", "BBB"}, filipino.Countries) {
+		t.Fatalf("Unexpected Filipino entry %v", filipino)
+	}
+	if unwritten, ok := catalog.Language("unwritten"); !ok || unwritten.Population != 1 {
+		t.Fatalf("Expected language without codes to be found by name, got %v", unwritten)
+	}
+	if len(catalog.Languages()) != 3 {
+		t.Fatalf("Expected 3 languages, got %v", catalog.Languages())
+	}
+}
@@ -0,0 +1,134 @@
+package countries
+
+import (
+	"sort"
+	"strings"
+)
+
+// translationKeys maps BCP 47 language and region pairs onto the
+// regional translation keys used by the countries API.
+var translationKeys = map[string]string{
+	"pt-br": "br",
+}
+
+// collationTailorings holds the letters that some languages sort
+// after z or after their base letter, instead of folding them away.
+var collationTailorings = map[string]map[rune]string{
+	"es": {'ñ': "n~"},
+	"sv": {'å': "z~", 'ä': "z~~", 'ö': "z~~~"},
+	"fi": {'å': "z~", 'ä': "z~~", 'ö': "z~~~"},
+	"da": {'æ': "z~", 'ø': "z~~", 'å': "z~~~"},
+	"nb": {'æ': "z~", 'ø': "z~~", 'å': "z~~~"},
+	"no": {'æ': "z~", 'ø': "z~~", 'å': "z~~~"},
+}
+
+// LocalizedName returns the name of the country in the language of the given
+// BCP 47 tag (e.g. pt-BR, de-AT, ja-JP).
+// Translations are looked up from the most to the least specific key, so
+// pt-BR tries br and then pt. When no translation exists the native name is
+// used, then the english name. English and empty tags return the english name,
+// which the API has no translation for.
+func (c Country) LocalizedName(lang string) string {
+	if primary := primaryLanguage(lang); primary == "" || primary == "en" {
+		return c.Name
+	}
+	for _, key := range translationChain(lang) {
+		if name := c.Translations[key]; name != "" {
+			return name
+		}
+	}
+	if c.NativeName != "" {
+		return c.NativeName
+	}
+
+	return c.Name
+}
+
+// SortByLocalizedName sorts the countries by their name in the language of
+// the given BCP 47 tag.
+// Names are compared case and accent insensitively, with the alphabet
+// tailorings of the language applied, and ties are broken by the exact name.
+func SortByLocalizedName(countries []Country, lang string) {
+	s := localizedSort{
+		countries: countries,
+		names:     make([]string, len(countries)),
+		keys:      make([]string, len(countries)),
+	}
+	for i, c := range countries {
+		s.names[i] = c.LocalizedName(lang)
+		s.keys[i] = collationKey(s.names[i], primaryLanguage(lang))
+	}
+
+	sort.Stable(s)
+}
+
+type localizedSort struct {
+	countries []Country
+	names     []string
+	keys      []string
+}
+
+func (s localizedSort) Len() int {
+	return len(s.countries)
+}
+
+func (s localizedSort) Less(i, j int) bool {
+	if s.keys[i] != s.keys[j] {
+		return s.keys[i] < s.keys[j]
+	}
+	return s.names[i] < s.names[j]
+}
+
+func (s localizedSort) Swap(i, j int) {
+	s.countries[i], s.countries[j] = s.countries[j], s.countries[i]
+	s.names[i], s.names[j] = s.names[j], s.names[i]
+	s.keys[i], s.keys[j] = s.keys[j], s.keys[i]
+}
+
+// translationChain returns the translation keys to try for a BCP 47 tag,
+// most specific first.
+func translationChain(lang string) []string {
+	tag := strings.ToLower(strings.Replace(strings.TrimSpace(lang), "_", "-", -1))
+	if tag == "" {
+		return nil
+	}
+
+	var chain []string
+	subtags := strings.Split(tag, "-")
+	for _, region := range subtags[1:] {
+		if key, ok := translationKeys[subtags[0]+"-"+region]; ok {
+			chain = append(chain, key)
+		}
+	}
+
+	return append(chain, subtags[0])
+}
+
+func primaryLanguage(lang string) string {
+	chain := translationChain(lang)
+	if len(chain) == 0 {
+		return ""
+	}
+
+	return chain[len(chain)-1]
+}
+
+// collationKey returns a key ordering s the way the language sorts it.
+func collationKey(s, lang string) string {
+	tailoring := collationTailorings[lang]
+	sb := strings.Builder{}
+	for _, word := range strings.Fields(strings.ToLower(s)) {
+		if sb.Len() > 0 {
+			sb.WriteByte(' ')
+		}
+		for _, r := range word {
+			if t, ok := tailoring[r]; ok {
+				sb.WriteString(t)
+			} else {
+				sb.WriteString(fold(string(r)))
+			}
+		}
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,85 @@
+package countries_test
+
+import (
+	"testing"
+
+	"github.com/georgesafta/countries"
+)
+
+func TestLocalizedName(t *testing.T) {
+	byCode := map[string]countries.Country{}
+	for _, c := range loadDataset(t) {
+		byCode[c.Alpha3Code] = c
+	}
+
+	expected := []struct {
+		code, lang, name string
+	}{
+		{"AUT", "pt-BR", "Áustria"},
+		{"AUT", "pt_br", "Áustria"},
+		{"AUT", "pt-PT", "áustria"},
+		{"AUT", "pt", "áustria"},
+		{"COL", "de-AT", "Kolumbien"},
+		{"COL", "ja-JP", "コロンビア"},
+		{"NPL", "ne-NP", "नेपाल"},
+		{"KOR", "ko", "대한민국"},
+		{"JPN", "ru", "日本"},
+		{"JPN", "", "Japan"},
+		{"DEU", "en", "Germany"},
+		{"DEU", "en-US", "Germany"},
+		{"DEU", "ru", "Deutschland"},
+	}
+
+	for _, e := range expected {
+		if name := byCode[e.code].LocalizedName(e.lang); name != e.name {
+			t.Fatalf("Expected %s for %s in %q, got %s", e.name, e.code, e.lang, name)
+		}
+	}
+	if name := (countries.Country{Name: "Japan"}).LocalizedName("ru"); name != "Japan" {
+		t.Fatalf("Expected the english name without a native name, got %s", name)
+	}
+}
+
+func TestSortByLocalizedName(t *testing.T) {
+	var list []countries.Country
+	for _, c := range loadDataset(t) {
+		switch c.Alpha3Code {
+		case "AUT", "COL", "DEU", "ECU":
+			list = append(list, c)
+		}
+	}
+
+	countries.SortByLocalizedName(list, "de-DE")
+	var names []string
+	for _, c := range list {
+		names = append(names, c.LocalizedName("de-DE"))
+	}
+	expected := []string{"Deutschland", "Ecuador", "Kolumbien", "Österreich"}
+	for i := range expected {
+		if names[i] != expected[i] {
+			t.Fatalf("Expected %v, got %v", expected, names)
+		}
+	}
+
+	countries.SortByLocalizedName(list, "en")
+	expected = []string{"AUT", "COL", "ECU", "DEU"}
+	for i := range expected {
+		if list[i].Alpha3Code != expected[i] {
+			t.Fatalf("Expected the english order %v, got %v", expected, list)
+		}
+	}
+}
+
+func TestSortByLocalizedNameTailoring(t *testing.T) {
+	list := []countries.Country{{Name: "Nz"}, {Name: "Ña"}, {Name: "nb"}}
+
+	countries.SortByLocalizedName(list, "en")
+	if list[0].Name != "Ña" || list[1].Name != "nb" || list[2].Name != "Nz" {
+		t.Fatalf("Unexpected english order %v", list)
+	}
+
+	countries.SortByLocalizedName(list, "es")
+	if list[0].Name != "nb" || list[1].Name != "Nz" || list[2].Name != "Ña" {
+		t.Fatalf("Unexpected spanish order %v", list)
+	}
+}
@@ -0,0 +1,193 @@
+package countries
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// defaultPrecision is the number of decimals of a currency missing from currencyPrecisions.
+const defaultPrecision = 2
+
+// currencyPrecisions holds the ISO 4217 minor units differing from defaultPrecision.
+var currencyPrecisions = map[string]int{
+	"BHD": 3, "BIF": 0, "CLP": 0, "DJF": 0, "GNF": 0, "IQD": 3, "ISK": 0, "JOD": 3,
+	"JPY": 0, "KMF": 0, "KRW": 0, "KWD": 3, "LYD": 3, "OMR": 3, "PYG": 0, "RWF": 0,
+	"TND": 3, "UGX": 0, "UYI": 0, "VND": 0, "VUV": 0, "XAF": 0, "XOF": 0, "XPF": 0,
+}
+
+// MoneyConvention describes how a locale writes amounts of money.
+// Grouping lists the sizes of the digit groups from the right, the last size
+// repeating, and SymbolSpace separates the symbol with a no-break space.
+type MoneyConvention struct {
+	Decimal     string
+	Group       string
+	Grouping    []int
+	SymbolFirst bool
+	SymbolSpace bool
+}
+
+var (
+	englishConvention = MoneyConvention{Decimal: ".", Group: ",", Grouping: []int{3}, SymbolFirst: true}
+	europeConvention  = MoneyConvention{Decimal: ",", Group: ".", Grouping: []int{3}, SymbolSpace: true}
+	indiaConvention   = MoneyConvention{Decimal: ".", Group: ",", Grouping: []int{3, 2}, SymbolFirst: true}
+)
+
+// moneyConventions holds the conventions by language, or by language and
+// ISO 3166 alpha-2 code when a country departs from its language.
+var moneyConventions = map[string]MoneyConvention{
+	"en":    englishConvention,
+	"en-in": indiaConvention,
+	"hi":    indiaConvention,
+	"de":    europeConvention,
+	"de-ch": {Decimal: ".", Group: "’", Grouping: []int{3}, SymbolFirst: true, SymbolSpace: true},
+	"es":    europeConvention,
+	"es-co": {Decimal: ",", Group: ".", Grouping: []int{3}, SymbolFirst: true, SymbolSpace: true},
+	"es-mx": englishConvention,
+	"es-us": englishConvention,
+	"fr":    {Decimal: ",", Group: "\u202f", Grouping: []int{3}, SymbolSpace: true},
+	"fr-ch": {Decimal: ".", Group: "\u202f", Grouping: []int{3}, SymbolSpace: true},
+	"it":    europeConvention,
+	"nl":    {Decimal: ",", Group: ".", Grouping: []int{3}, SymbolFirst: true, SymbolSpace: true},
+	"pt":    europeConvention,
+	"pt-br": {Decimal: ",", Group: ".", Grouping: []int{3}, SymbolFirst: true, SymbolSpace: true},
+	"ja":    englishConvention,
+	"ko":    englishConvention,
+	"zh":    englishConvention,
+}
+
+// MoneyFormatter formats amounts of money in the local convention of a country.
+type MoneyFormatter struct {
+	// Precision overrides the number of decimals of a currency, keyed by ISO 4217 code.
+	Precision map[string]int
+}
+
+// NewMoneyFormatter returns a new MoneyFormatter using the ISO 4217 precisions.
+func NewMoneyFormatter() *MoneyFormatter {
+	return &MoneyFormatter{Precision: map[string]int{}}
+}
+
+// Format formats the amount in the primary currency of the country.
+// The language is a BCP 47 tag, when empty the first language of the country is used.
+// Returns an error when the country has no currency.
+func (f *MoneyFormatter) Format(amount float64, country Country, lang string) (string, error) {
+	for _, cur := range country.Currencies {
+		if currencyCode(cur) != "" {
+			return f.FormatCurrency(amount, cur, country, lang), nil
+		}
+	}
+
+	return "", fmt.Errorf("No currency for %s", country.Alpha3Code)
+}
+
+// FormatCurrency formats the amount in the given currency, with the convention of the country.
+// The currency symbol is used when known, otherwise its code.
+func (f *MoneyFormatter) FormatCurrency(amount float64, currency Currency, country Country, lang string) string {
+	code := currencyCode(currency)
+	convention := Convention(country, lang)
+	number := formatNumber(amount, f.precision(code), convention)
+
+	symbol := currency.Symbol
+	if symbol == "" {
+		symbol = code
+	}
+	separator := ""
+	if convention.SymbolSpace {
+		separator = "\u00a0"
+	}
+
+	sign := ""
+	if strings.HasPrefix(number, "-") {
+		sign, number = "-", number[1:]
+	}
+	if convention.SymbolFirst {
+		return sign + symbol + separator + number
+	}
+
+	return sign + number + separator + symbol
+}
+
+// Convention returns the money convention of the language in the country.
+// The language is a BCP 47 tag, when empty the first language of the country is used.
+// Unknown languages fall back to the english convention.
+func Convention(country Country, lang string) MoneyConvention {
+	primary := primaryLanguage(lang)
+	if primary == "" && len(country.Languages) > 0 {
+		primary = strings.ToLower(country.Languages[0].Iso6391)
+	}
+	if c, ok := moneyConventions[primary+"-"+strings.ToLower(country.Alpha2Code)]; ok {
+		return c
+	}
+	if c, ok := moneyConventions[primary]; ok {
+		return c
+	}
+
+	return englishConvention
+}
+
+func (f *MoneyFormatter) precision(code string) int {
+	if p, ok := f.Precision[code]; ok {
+		return p
+	}
+	if p, ok := currencyPrecisions[code]; ok {
+		return p
+	}
+
+	return defaultPrecision
+}
+
+// formatNumber formats the amount with the given number of decimals and the
+// separators of the convention. Negative amounts start with a minus sign,
+// unless they round to zero.
+func formatNumber(amount float64, precision int, convention MoneyConvention) string {
+	if precision < 0 {
+		precision = 0
+	}
+	digits := strconv.FormatFloat(amount, 'f', precision, 64)
+	negative := strings.HasPrefix(digits, "-")
+	digits = strings.TrimPrefix(digits, "-")
+
+	integer, fraction := digits, ""
+	if i := strings.IndexByte(digits, '.'); i >= 0 {
+		integer, fraction = digits[:i], digits[i+1:]
+	}
+	if strings.Trim(integer+fraction, "0") == "" {
+		negative = false
+	}
+
+	sb := strings.Builder{}
+	if negative {
+		sb.WriteByte('-')
+	}
+	sb.WriteString(group(integer, convention))
+	if fraction != "" {
+		sb.WriteString(convention.Decimal)
+		sb.WriteString(fraction)
+	}
+
+	return sb.String()
+}
+
+// group inserts the group separator in the integer part, the last size of the
+// grouping repeating for the remaining digits.
+func group(integer string, convention MoneyConvention) string {
+	if len(convention.Grouping) == 0 {
+		return integer
+	}
+
+	var groups []string
+	for i := 0; len(integer) > 0; i++ {
+		size := convention.Grouping[len(convention.Grouping)-1]
+		if i < len(convention.Grouping) {
+			size = convention.Grouping[i]
+		}
+		if size <= 0 || size >= len(integer) {
+			groups = append([]string{integer}, groups...)
+			break
+		}
+		groups = append([]string{integer[len(integer)-size:]}, groups...)
+		integer = integer[:len(integer)-size]
+	}
+
+	return strings.Join(groups, convention.Group)
+}
@@ -0,0 +1,69 @@
+package countries_test
+
+import (
+	"testing"
+
+	"github.com/georgesafta/countries"
+)
+
+func TestMoneyFormatterFormat(t *testing.T) {
+	byCode := map[string]countries.Country{}
+	for _, c := range loadDataset(t) {
+		byCode[c.Alpha3Code] = c
+	}
+	formatter := countries.NewMoneyFormatter()
+
+	expected := []struct {
+		amount   float64
+		code     string
+		lang     string
+		expected string
+	}{
+		{1234.56, "DEU", "", "1.234,56\u00a0€"},
+		{1234.56, "DEU", "en-GB", "€1,234.56"},
+		{-1234.56, "DEU", "", "-1.234,56\u00a0€"},
+		{-1234.56, "USA", "", "-$1,234.56"},
+		{1234.56, "FRA", "", "1\u202f234,56\u00a0€"},
+		{1234.56, "BRA", "pt-BR", "R$\u00a01.234,56"},
+		{1234.56, "CHE", "", "Fr\u00a01’234.56"},
+		{1234.6, "JPN", "", "¥1,235"},
+		{12345678.9, "IND", "", "₹1,23,45,678.90"},
+		{1234567.891, "COL", "", "$\u00a01.234.567,89"},
+		{1000, "CIV", "", "1\u202f000\u00a0Fr"},
+		{-0.001, "USA", "", "$0.00"},
+		{0.5, "USA", "", "$0.50"},
+	}
+
+	for _, e := range expected {
+		got, err := formatter.Format(e.amount, byCode[e.code], e.lang)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if got != e.expected {
+			t.Fatalf("Expected %q for %v in %s, got %q", e.expected, e.amount, e.code, got)
+		}
+	}
+}
+
+func TestMoneyFormatterPrecision(t *testing.T) {
+	formatter := countries.NewMoneyFormatter()
+	formatter.Precision["EUR"] = 0
+	formatter.Precision["JPY"] = 2
+
+	eur := countries.Country{Alpha2Code: "DE", Languages: []countries.Language{{Iso6391: "de"}}, Currencies: []countries.Currency{{Code: "EUR", Symbol: "€"}}}
+	if got, _ := formatter.Format(1234.56, eur, ""); got != "1.235\u00a0€" {
+		t.Fatalf("Expected precision override, got %q", got)
+	}
+
+	jpy := countries.Country{Currencies: []countries.Currency{{Code: "JPY"}}}
+	if got, _ := formatter.Format(1234.5, jpy, ""); got != "JPY1,234.50" {
+		t.Fatalf("Expected code when symbol is missing, got %q", got)
+	}
+}
+
+func TestMoneyFormatterNoCurrency(t *testing.T) {
+	_, err := countries.NewMoneyFormatter().Format(1, countries.Country{Alpha3Code: "XXX"}, "")
+	if err == nil || err.Error() != "No currency for XXX" {
+		t.Fatalf("Expected missing currency error, got %v", err)
+	}
+}
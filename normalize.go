@@ -0,0 +1,53 @@
+package countries
+
+import (
+	"strings"
+	"unicode"
+)
+
+// foldTable maps accented latin letters to their unaccented form.
+var foldTable = map[rune]string{
+	'à': "a", 'á': "a", 'â': "a", 'ã': "a", 'ä': "a", 'å': "a", 'ā': "a", 'ă': "a", 'ą': "a",
+	'æ': "ae",
+	'ç': "c", 'ć': "c", 'č': "c",
+	'ď': "d", 'đ': "d",
+	'è': "e", 'é': "e", 'ê': "e", 'ë': "e", 'ē': "e", 'ė': "e", 'ę': "e", 'ě': "e",
+	'ğ': "g",
+	'ì': "i", 'í': "i", 'î': "i", 'ï': "i", 'ī': "i", 'ı': "i",
+	'ł': "l",
+	'ñ': "n", 'ń': "n", 'ň': "n",
+	'ò': "o", 'ó': "o", 'ô': "o", 'õ': "o", 'ö': "o", 'ø': "o", 'ō': "o", 'ő': "o",
+	'œ': "oe",
+	'ř': "r",
+	'ś': "s", 'ş': "s", 'š': "s", 'ß': "ss",
+	'ť': "t", 'ţ': "t",
+	'ù': "u", 'ú': "u", 'û': "u", 'ü': "u", 'ū': "u", 'ů': "u", 'ű': "u",
+	'ý': "y", 'ÿ': "y",
+	'ź': "z", 'ż': "z", 'ž': "z",
+}
+
+// fold normalises s for case and accent insensitive comparisons.
+// Letters are lower cased and stripped of accents, punctuation is dropped
+// and runs of spaces or dashes collapse into a single space.
+func fold(s string) string {
+	sb := strings.Builder{}
+	space := false
+	for _, r := range strings.ToLower(s) {
+		switch {
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			if space && sb.Len() > 0 {
+				sb.WriteByte(' ')
+			}
+			space = false
+			if f, ok := foldTable[r]; ok {
+				sb.WriteString(f)
+			} else {
+				sb.WriteRune(r)
+			}
+		case unicode.IsSpace(r) || r == '-' || r == '_':
+			space = true
+		}
+	}
+
+	return sb.String()
+}
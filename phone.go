@@ -0,0 +1,185 @@
+package countries
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// maxE164Digits is the maximum number of digits of an E.164 number.
+const maxE164Digits = 15
+
+// PhoneMatch is the result of matching a phone number against calling codes.
+// Countries holds every country sharing the calling code, such as the members
+// of the North American Numbering Plan for code 1.
+type PhoneMatch struct {
+	CallingCode string
+	National    string
+	Countries   []Country
+}
+
+// PhoneMatcher finds the country of E.164 phone numbers by longest calling code prefix.
+// Calling codes with sub-prefixes, such as 1787 for Puerto Rico within code 1,
+// take precedence over the shorter codes they extend.
+type PhoneMatcher struct {
+	codes     map[string][]string
+	countries map[string]Country
+	maxLength int
+}
+
+// NewPhoneMatcher returns a new PhoneMatcher built from the CallingCodes of the given countries.
+func NewPhoneMatcher(countries []Country) *PhoneMatcher {
+	m := &PhoneMatcher{
+		codes:     map[string][]string{},
+		countries: make(map[string]Country, len(countries)),
+	}
+	for _, c := range countries {
+		m.countries[strings.ToUpper(c.Alpha3Code)] = c
+		for _, code := range c.CallingCodes {
+			code = digits(code)
+			if code == "" || containsCode(m.codes[code], c.Alpha3Code) {
+				continue
+			}
+			m.codes[code] = append(m.codes[code], c.Alpha3Code)
+			if len(code) > m.maxLength {
+				m.maxLength = len(code)
+			}
+		}
+	}
+	for code := range m.codes {
+		sort.Strings(m.codes[code])
+	}
+
+	return m
+}
+
+// Match returns the countries a phone number belongs to.
+// The number must be in international format, starting with + or 00.
+// Spaces, dots, dashes and parentheses are ignored.
+func (m *PhoneMatcher) Match(number string) (PhoneMatch, error) {
+	n, err := normalizePhone(number)
+	if err != nil {
+		return PhoneMatch{}, err
+	}
+
+	for length := minInt(m.maxLength, len(n)); length > 0; length-- {
+		codes, ok := m.codes[n[:length]]
+		if !ok {
+			continue
+		}
+		match := PhoneMatch{CallingCode: n[:length], National: n[length:]}
+		for _, code := range codes {
+			match.Countries = append(match.Countries, m.countries[strings.ToUpper(code)])
+		}
+		return match, nil
+	}
+
+	return PhoneMatch{}, fmt.Errorf("No calling code matching %s", number)
+}
+
+// Validate checks that a phone number belongs to the country with the given alpha-3 code.
+func (m *PhoneMatcher) Validate(number, alpha3 string) error {
+	match, err := m.Match(number)
+	if err != nil {
+		return err
+	}
+	for _, c := range match.Countries {
+		if strings.EqualFold(c.Alpha3Code, alpha3) {
+			return nil
+		}
+	}
+
+	codes := make([]string, len(match.Countries))
+	for i, c := range match.Countries {
+		codes[i] = c.Alpha3Code
+	}
+
+	return fmt.Errorf("Phone number %s does not belong to %s, calling code %s is used by %s", number, strings.ToUpper(alpha3), match.CallingCode, strings.Join(codes, ", "))
+}
+
+// Format returns the national number of a country in E.164 format.
+// A leading trunk prefix 0 is dropped. When the country uses a sub-prefixed
+// calling code and the national number already starts with the sub-prefix,
+// as NANP numbers include their area code, only the shorter code is added.
+func (m *PhoneMatcher) Format(alpha3, national string) (string, error) {
+	country, ok := m.countries[strings.ToUpper(alpha3)]
+	if !ok {
+		return "", fmt.Errorf("Unknown country code %s", alpha3)
+	}
+	n := strings.TrimLeft(digits(national), "0")
+	if n == "" {
+		return "", fmt.Errorf("Invalid national number %q", national)
+	}
+
+	var codes []string
+	for _, code := range country.CallingCodes {
+		if code = digits(code); code != "" {
+			codes = append(codes, code)
+		}
+	}
+	if len(codes) == 0 {
+		return "", fmt.Errorf("No calling code for %s", country.Alpha3Code)
+	}
+
+	result := codes[0] + n
+	for _, code := range codes {
+		if root := m.root(code); root != "" && strings.HasPrefix(n, code[len(root):]) {
+			result = root + n
+			break
+		}
+	}
+	if len(result) > maxE164Digits {
+		return "", fmt.Errorf("Phone number +%s is longer than %d digits", result, maxE164Digits)
+	}
+
+	return "+" + result, nil
+}
+
+// root returns the longest registered calling code the given code extends.
+func (m *PhoneMatcher) root(code string) string {
+	for length := len(code) - 1; length > 0; length-- {
+		if _, ok := m.codes[code[:length]]; ok {
+			return code[:length]
+		}
+	}
+
+	return ""
+}
+
+// normalizePhone returns the digits of an international phone number.
+func normalizePhone(number string) (string, error) {
+	s := strings.TrimSpace(number)
+	switch {
+	case strings.HasPrefix(s, "+"):
+		s = s[1:]
+	case strings.HasPrefix(s, "00"):
+		s = s[2:]
+	default:
+		return "", fmt.Errorf("Phone number %s is not in international format", number)
+	}
+
+	sb := strings.Builder{}
+	for _, r := range s {
+		switch {
+		case r >= '0' && r <= '9':
+			sb.WriteRune(r)
+		case r == ' ' || r == '-' || r == '.' || r == '(' || r == ')':
+		default:
+			return "", fmt.Errorf("Invalid character %q in phone number %s", r, number)
+		}
+	}
+	n := sb.String()
+	if n == "" || len(n) > maxE164Digits {
+		return "", fmt.Errorf("Phone number %s must have between 1 and %d digits", number, maxE164Digits)
+	}
+
+	return n, nil
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+
+	return b
+}
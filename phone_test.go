@@ -0,0 +1,100 @@
+package countries_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/georgesafta/countries"
+)
+
+func TestPhoneMatcherMatch(t *testing.T) {
+	matcher := countries.NewPhoneMatcher(loadDataset(t))
+
+	expected := []struct {
+		number   string
+		code     string
+		national string
+		matches  []string
+	}{
+		{"+44 20 7946 0958", "44", "2079460958", []string{"GBR"}},
+		{"0044 (20) 7946-0958", "44", "2079460958", []string{"GBR"}},
+		{"+1 212 555 0100", "1", "2125550100", []string{"CAN", "USA"}},
+		{"+1 787 555 0100", "1787", "5550100", []string{"PRI"}},
+		{"+593 2 123 4567", "593", "21234567", []string{"ECU"}},
+		{"+591 2 123 4567", "591", "21234567", []string{"BOL"}},
+	}
+
+	for _, e := range expected {
+		match, err := matcher.Match(e.number)
+		if err != nil {
+			t.Fatalf("Unexpected error for %s: %v", e.number, err)
+		}
+		var codes []string
+		for _, c := range match.Countries {
+			codes = append(codes, c.Alpha3Code)
+		}
+		if match.CallingCode != e.code || match.National != e.national || !reflect.DeepEqual(e.matches, codes) {
+			t.Fatalf("Unexpected match for %s: %s %s %v", e.number, match.CallingCode, match.National, codes)
+		}
+	}
+}
+
+func TestPhoneMatcherMatchErrors(t *testing.T) {
+	matcher := countries.NewPhoneMatcher(loadDataset(t))
+
+	expected := map[string]string{
+		"020 7946 0958":     "Phone number 020 7946 0958 is not in international format",
+		"+44 20 7946 095x":  `Invalid character 'x' in phone number +44 20 7946 095x`,
+		"+":                 "Phone number + must have between 1 and 15 digits",
+		"+1234567890123456": "Phone number +1234567890123456 must have between 1 and 15 digits",
+		"+999 123":          "No calling code matching +999 123",
+	}
+	for number, message := range expected {
+		_, err := matcher.Match(number)
+		if err == nil || err.Error() != message {
+			t.Fatalf("Expected error %s, got %v", message, err)
+		}
+	}
+}
+
+func TestPhoneMatcherValidate(t *testing.T) {
+	matcher := countries.NewPhoneMatcher(loadDataset(t))
+
+	if err := matcher.Validate("+1 212 555 0100", "can"); err != nil {
+		t.Fatalf("Expected valid number, got %v", err)
+	}
+	err := matcher.Validate("+1 787 555 0100", "USA")
+	if err == nil || err.Error() != "Phone number +1 787 555 0100 does not belong to USA, calling code 1787 is used by PRI" {
+		t.Fatalf("Expected mismatch error, got %v", err)
+	}
+}
+
+func TestPhoneMatcherFormat(t *testing.T) {
+	matcher := countries.NewPhoneMatcher(loadDataset(t))
+
+	expected := []struct {
+		code, national, expected string
+	}{
+		{"GBR", "020 7946 0958", "+442079460958"},
+		{"USA", "(212) 555-0100", "+12125550100"},
+		{"PRI", "787 555 0100", "+17875550100"},
+		{"PRI", "939 555 0100", "+19395550100"},
+		{"PRI", "555 0100", "+17875550100"},
+	}
+	for _, e := range expected {
+		got, err := matcher.Format(e.code, e.national)
+		if err != nil || got != e.expected {
+			t.Fatalf("Expected %s for %s %s, got %s, %v", e.expected, e.code, e.national, got, err)
+		}
+		if err := matcher.Validate(got, e.code); err != nil {
+			t.Fatalf("Expected formatted number to validate, got %v", err)
+		}
+	}
+
+	if _, err := matcher.Format("XXX", "123"); err == nil || err.Error() != "Unknown country code XXX" {
+		t.Fatalf("Expected unknown country error, got %v", err)
+	}
+	if _, err := matcher.Format("GBR", "000"); err == nil || err.Error() != `Invalid national number "000"` {
+		t.Fatalf("Expected invalid number error, got %v", err)
+	}
+}
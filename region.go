@@ -0,0 +1,130 @@
+package countries
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Region is a world region as used by the countries API.
+type Region string
+
+// Regions of the countries API.
+const (
+	Africa   Region = "Africa"
+	Americas Region = "Americas"
+	Asia     Region = "Asia"
+	Europe   Region = "Europe"
+	Oceania  Region = "Oceania"
+	Polar    Region = "Polar"
+)
+
+var regions = []Region{Africa, Americas, Asia, Europe, Oceania, Polar}
+
+// Regions returns every region, sorted.
+func Regions() []Region {
+	return append([]Region(nil), regions...)
+}
+
+// ParseRegion returns the region matching the input, ignoring case and accents.
+// Use it to validate the input before calling ByRegion.
+func ParseRegion(s string) (Region, error) {
+	key := fold(s)
+	for _, r := range regions {
+		if fold(string(r)) == key {
+			return r, nil
+		}
+	}
+
+	return "", fmt.Errorf("Unknown region %q", s)
+}
+
+// Valid returns true if the region is one of the regions of the countries API.
+func (r Region) Valid() bool {
+	for _, region := range regions {
+		if r == region {
+			return true
+		}
+	}
+
+	return false
+}
+
+// RegionTree is the hierarchy of regions, subregions and countries derived from a dataset.
+// Countries without a subregion are only listed under their region.
+type RegionTree struct {
+	subregions map[Region][]string
+	parents    map[string]Region
+	regions    map[Region][]Country
+	countries  map[string][]Country
+	names      map[string]string
+}
+
+// NewRegionTree returns a new RegionTree built from the Region and Subregion of the given countries.
+// Countries with an unknown region are left out.
+func NewRegionTree(countries []Country) *RegionTree {
+	t := &RegionTree{
+		subregions: map[Region][]string{},
+		parents:    map[string]Region{},
+		regions:    map[Region][]Country{},
+		countries:  map[string][]Country{},
+		names:      map[string]string{},
+	}
+	for _, c := range countries {
+		region, err := ParseRegion(c.Region)
+		if err != nil {
+			continue
+		}
+		t.regions[region] = append(t.regions[region], c)
+
+		if c.Subregion == "" {
+			continue
+		}
+		key := fold(c.Subregion)
+		if _, ok := t.names[key]; !ok {
+			t.names[key] = c.Subregion
+			t.parents[key] = region
+			t.subregions[region] = append(t.subregions[region], c.Subregion)
+		}
+		t.countries[key] = append(t.countries[key], c)
+	}
+	for r := range t.subregions {
+		sort.Strings(t.subregions[r])
+	}
+	for _, cs := range t.regions {
+		sortByAlpha3(cs)
+	}
+	for _, cs := range t.countries {
+		sortByAlpha3(cs)
+	}
+
+	return t
+}
+
+// Subregions returns the subregions of the region, sorted.
+func (t *RegionTree) Subregions(region Region) []string {
+	return append([]string(nil), t.subregions[region]...)
+}
+
+// Region returns the region of the subregion, ignoring case and accents.
+func (t *RegionTree) Region(subregion string) (Region, bool) {
+	r, ok := t.parents[fold(subregion)]
+	return r, ok
+}
+
+// Countries returns the countries of the region, sorted by alpha-3 code.
+func (t *RegionTree) Countries(region Region) []Country {
+	return append([]Country(nil), t.regions[region]...)
+}
+
+// SubregionCountries returns the countries of the subregion, ignoring case and accents,
+// sorted by alpha-3 code.
+func (t *RegionTree) SubregionCountries(subregion string) []Country {
+	return append([]Country(nil), t.countries[fold(subregion)]...)
+}
+
+func sortByAlpha3(countries []Country) {
+	sort.Slice(countries, func(i, j int) bool {
+		return strings.ToUpper(countries[i].Alpha3Code) < strings.ToUpper(countries[j].Alpha3Code)
+	})
+}
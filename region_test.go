@@ -0,0 +1,66 @@
+package countries_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/georgesafta/countries"
+)
+
+func TestParseRegion(t *testing.T) {
+	for input, expected := range map[string]countries.Region{
+		"Europe":   countries.Europe,
+		"americas": countries.Americas,
+		" ASIA ":   countries.Asia,
+		"polar":    countries.Polar,
+	} {
+		r, err := countries.ParseRegion(input)
+		if err != nil || r != expected {
+			t.Fatalf("Expected %s for %q, got %s, %v", expected, input, r, err)
+		}
+	}
+
+	if _, err := countries.ParseRegion("Antarctica"); err == nil || err.Error() != `Unknown region "Antarctica"` {
+		t.Fatalf("Expected unknown region error, got %v", err)
+	}
+	if countries.Region("europe").Valid() || !countries.Oceania.Valid() {
+		t.Fatal("Expected only exact regions to be valid")
+	}
+	if len(countries.Regions()) != 6 {
+		t.Fatalf("Expected 6 regions, got %v", countries.Regions())
+	}
+}
+
+func TestRegionTree(t *testing.T) {
+	tree := countries.NewRegionTree(loadDataset(t))
+
+	expected := []string{"Caribbean", "Central America", "Northern America", "South America"}
+	if s := tree.Subregions(countries.Americas); !reflect.DeepEqual(s, expected) {
+		t.Fatalf("Expected %v, got %v", expected, s)
+	}
+	if s := tree.Subregions(countries.Polar); len(s) != 0 {
+		t.Fatalf("Expected no polar subregion, got %v", s)
+	}
+
+	if r, ok := tree.Region("western africa"); !ok || r != countries.Africa {
+		t.Fatalf("Expected Africa, got %s, %v", r, ok)
+	}
+	if _, ok := tree.Region("Atlantis"); ok {
+		t.Fatal("Expected unknown subregion")
+	}
+
+	var codes []string
+	for _, c := range tree.SubregionCountries("Eastern Asia") {
+		codes = append(codes, c.Alpha3Code)
+	}
+	if !reflect.DeepEqual(codes, []string{"CHN", "JPN", "KOR"}) {
+		t.Fatalf("Unexpected Eastern Asia countries %v", codes)
+	}
+
+	if cs := tree.Countries(countries.Polar); len(cs) != 1 || cs[0].Alpha3Code != "ATA" {
+		t.Fatalf("Expected Antarctica in Polar, got %v", cs)
+	}
+	if cs := tree.Countries(countries.Europe); len(cs) != 8 {
+		t.Fatalf("Expected 8 european countries, got %d", len(cs))
+	}
+}
@@ -0,0 +1,235 @@
+package countries
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// MatchField is the Country field an input was matched on.
+// Values follow the API field names, as used by the fields filter.
+type MatchField string
+
+// Fields the resolver matches on.
+const (
+	MatchName           MatchField = "name"
+	MatchAlpha2Code     MatchField = "alpha2Code"
+	MatchAlpha3Code     MatchField = "alpha3Code"
+	MatchNumericCode    MatchField = "numericCode"
+	MatchCioc           MatchField = "cioc"
+	MatchCallingCode    MatchField = "callingCodes"
+	MatchTopLevelDomain MatchField = "topLevelDomain"
+	MatchAltSpelling    MatchField = "altSpellings"
+	MatchTranslation    MatchField = "translations"
+	MatchNativeName     MatchField = "nativeName"
+)
+
+// confidences scores an exact match on each field.
+var confidences = map[MatchField]float64{
+	MatchName:           1.0,
+	MatchAlpha2Code:     1.0,
+	MatchAlpha3Code:     1.0,
+	MatchNumericCode:    0.95,
+	MatchNativeName:     0.95,
+	MatchCioc:           0.9,
+	MatchAltSpelling:    0.9,
+	MatchTranslation:    0.85,
+	MatchTopLevelDomain: 0.8,
+	MatchCallingCode:    0.7,
+}
+
+// bareCallingCodePenalty lowers the confidence of digits without a leading +
+// being read as a calling code.
+const bareCallingCodePenalty = 0.2
+
+// Resolution is a country matched by the Resolver.
+type Resolution struct {
+	Country    Country
+	Field      MatchField
+	Confidence float64
+}
+
+// AmbiguousError is returned when an input matches several countries equally well.
+type AmbiguousError struct {
+	Input      string
+	Candidates []Resolution
+}
+
+func (e *AmbiguousError) Error() string {
+	codes := make([]string, len(e.Candidates))
+	for i, c := range e.Candidates {
+		codes[i] = c.Country.Alpha3Code
+	}
+
+	return fmt.Sprintf("Ambiguous input %q, matching %s", e.Input, strings.Join(codes, ", "))
+}
+
+// NoMatchError is returned when an input does not match any country.
+type NoMatchError struct {
+	Input string
+}
+
+func (e *NoMatchError) Error() string {
+	return fmt.Sprintf("No country matching %q", e.Input)
+}
+
+type resolverEntry struct {
+	index int
+	field MatchField
+}
+
+// Resolver matches free text against every identifier of a set of countries.
+type Resolver struct {
+	countries []Country
+	index     map[string][]resolverEntry
+}
+
+// NewResolver returns a new Resolver over the given countries.
+func NewResolver(countries []Country) *Resolver {
+	r := &Resolver{
+		countries: countries,
+		index:     map[string][]resolverEntry{},
+	}
+	for i, c := range countries {
+		r.add(fold(c.Name), i, MatchName)
+		r.add(fold(c.Alpha2Code), i, MatchAlpha2Code)
+		r.add(fold(c.Alpha3Code), i, MatchAlpha3Code)
+		r.add(c.NumericCode, i, MatchNumericCode)
+		r.add(fold(c.Cioc), i, MatchCioc)
+		r.add(fold(c.NativeName), i, MatchNativeName)
+		for _, code := range c.CallingCodes {
+			if code != "" {
+				r.add("+"+code, i, MatchCallingCode)
+			}
+		}
+		for _, tld := range c.TopLevelDomain {
+			if tld != "" {
+				r.add(strings.ToLower(tld), i, MatchTopLevelDomain)
+			}
+		}
+		for _, s := range c.AltSpellings {
+			r.add(fold(s), i, MatchAltSpelling)
+		}
+		for _, t := range c.Translations {
+			r.add(fold(t), i, MatchTranslation)
+		}
+	}
+
+	return r
+}
+
+func (r *Resolver) add(key string, index int, field MatchField) {
+	if key == "" {
+		return
+	}
+	for _, e := range r.index[key] {
+		if e.index == index && e.field == field {
+			return
+		}
+	}
+	r.index[key] = append(r.index[key], resolverEntry{index: index, field: field})
+}
+
+// Resolve returns the country that best matches the input.
+// Returns a *NoMatchError when nothing matches and an *AmbiguousError
+// when several countries share the best confidence.
+func (r *Resolver) Resolve(input string) (Resolution, error) {
+	candidates := r.Candidates(input)
+	if len(candidates) == 0 {
+		return Resolution{}, &NoMatchError{Input: input}
+	}
+
+	best := candidates[:1]
+	for _, c := range candidates[1:] {
+		if c.Confidence < best[0].Confidence {
+			break
+		}
+		best = candidates[:len(best)+1]
+	}
+	if len(best) > 1 {
+		return Resolution{}, &AmbiguousError{Input: input, Candidates: best}
+	}
+
+	return best[0], nil
+}
+
+// Candidates returns every country matching the input, best match first.
+// Each country appears once, with the field giving its highest confidence.
+func (r *Resolver) Candidates(input string) []Resolution {
+	input = strings.TrimSpace(input)
+	best := map[int]Resolution{}
+	collect := func(key string, penalty float64, allowed ...MatchField) {
+		for _, e := range r.index[key] {
+			if len(allowed) > 0 && !containsField(allowed, e.field) {
+				continue
+			}
+			confidence := confidences[e.field] - penalty
+			if prev, ok := best[e.index]; ok && prev.Confidence >= confidence {
+				continue
+			}
+			best[e.index] = Resolution{Country: r.countries[e.index], Field: e.field, Confidence: confidence}
+		}
+	}
+
+	switch {
+	case strings.HasPrefix(input, "+"):
+		collect("+"+digits(input), 0, MatchCallingCode)
+	case strings.HasPrefix(input, "."):
+		collect(strings.ToLower(input), 0, MatchTopLevelDomain)
+	case isDigits(input) && len(input) > 3 && strings.HasPrefix(input, "00"):
+		collect("+"+input[2:], 0, MatchCallingCode)
+	case isDigits(input):
+		collect(input, 0, MatchNumericCode)
+		collect("+"+input, bareCallingCodePenalty, MatchCallingCode)
+	default:
+		collect(fold(input), 0)
+	}
+
+	result := make([]Resolution, 0, len(best))
+	for _, res := range best {
+		result = append(result, res)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Confidence != result[j].Confidence {
+			return result[i].Confidence > result[j].Confidence
+		}
+		return result[i].Country.Alpha3Code < result[j].Country.Alpha3Code
+	})
+
+	return result
+}
+
+func containsField(fields []MatchField, field MatchField) bool {
+	for _, f := range fields {
+		if f == field {
+			return true
+		}
+	}
+
+	return false
+}
+
+// digits returns the digits of s, dropping every other character.
+func digits(s string) string {
+	sb := strings.Builder{}
+	for _, r := range s {
+		if r >= '0' && r <= '9' {
+			sb.WriteRune(r)
+		}
+	}
+
+	return sb.String()
+}
+
+func isDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+
+	return true
+}
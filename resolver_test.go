@@ -0,0 +1,104 @@
+package countries_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+
+	"github.com/georgesafta/countries"
+)
+
+var datasetMockPath = "mock/dataset.json"
+
+func loadDataset(t *testing.T) []countries.Country {
+	data, err := ioutil.ReadFile(datasetMockPath)
+	if err != nil {
+		t.Fatalf("Cannot read dataset: %v", err)
+	}
+	var c []countries.Country
+	if err := json.Unmarshal(data, &c); err != nil {
+		t.Fatalf("Cannot decode dataset: %v", err)
+	}
+
+	return c
+}
+
+func TestResolve(t *testing.T) {
+	resolver := countries.NewResolver(loadDataset(t))
+	expected := []struct {
+		input string
+		code  string
+		field countries.MatchField
+	}{
+		{"UK", "GBR", countries.MatchAltSpelling},
+		{"U.S.A.", "USA", countries.MatchAlpha3Code},
+		{"Republic of Korea", "KOR", countries.MatchAltSpelling},
+		{"Deutschland", "DEU", countries.MatchNativeName},
+		{"+44", "GBR", countries.MatchCallingCode},
+		{"0044", "GBR", countries.MatchCallingCode},
+		{".fr", "FRA", countries.MatchTopLevelDomain},
+		{"GBR", "GBR", countries.MatchAlpha3Code},
+		{"GER", "DEU", countries.MatchCioc},
+		{"co", "COL", countries.MatchAlpha2Code},
+		{"170", "COL", countries.MatchNumericCode},
+		{"Kolumbien", "COL", countries.MatchTranslation},
+		{"cote d'ivoire", "CIV", countries.MatchName},
+		{"  COLOMBIA ", "COL", countries.MatchName},
+	}
+
+	for _, e := range expected {
+		res, err := resolver.Resolve(e.input)
+		if err != nil {
+			t.Fatalf("Unexpected error for %q: %v", e.input, err)
+		}
+		if res.Country.Alpha3Code != e.code || res.Field != e.field {
+			t.Fatalf("Expected %s on %s for %q, got %s on %s", e.code, e.field, e.input, res.Country.Alpha3Code, res.Field)
+		}
+		if res.Confidence <= 0 || res.Confidence > 1 {
+			t.Fatalf("Unexpected confidence %v for %q", res.Confidence, e.input)
+		}
+	}
+}
+
+func TestResolveConfidenceOrder(t *testing.T) {
+	resolver := countries.NewResolver(loadDataset(t))
+	name, _ := resolver.Resolve("Colombia")
+	translation, _ := resolver.Resolve("Kolumbien")
+	callingCode, _ := resolver.Resolve("57")
+	if !(name.Confidence > translation.Confidence && translation.Confidence > callingCode.Confidence) {
+		t.Fatalf("Unexpected confidences %v, %v, %v", name.Confidence, translation.Confidence, callingCode.Confidence)
+	}
+}
+
+func TestResolveAmbiguous(t *testing.T) {
+	resolver := countries.NewResolver(loadDataset(t))
+	_, err := resolver.Resolve("+1")
+	ambiguous, ok := err.(*countries.AmbiguousError)
+	if !ok {
+		t.Fatalf("Expected ambiguous error, got %v", err)
+	}
+	if len(ambiguous.Candidates) != 2 || ambiguous.Candidates[0].Country.Alpha3Code != "CAN" || ambiguous.Candidates[1].Country.Alpha3Code != "USA" {
+		t.Fatalf("Unexpected candidates %v", ambiguous.Candidates)
+	}
+	if err.Error() != `Ambiguous input "+1", matching CAN, USA` {
+		t.Fatalf("Unexpected error message %s", err.Error())
+	}
+}
+
+func TestResolveNoMatch(t *testing.T) {
+	resolver := countries.NewResolver(loadDataset(t))
+	for _, input := range []string{"", "Atlantis", "+999", ".zz"} {
+		_, err := resolver.Resolve(input)
+		if _, ok := err.(*countries.NoMatchError); !ok {
+			t.Fatalf("Expected no match error for %q, got %v", input, err)
+		}
+	}
+}
+
+func TestCandidates(t *testing.T) {
+	resolver := countries.NewResolver(loadDataset(t))
+	candidates := resolver.Candidates("GBR")
+	if len(candidates) != 1 || candidates[0].Field != countries.MatchAlpha3Code {
+		t.Fatalf("Expected a single deduplicated candidate, got %v", candidates)
+	}
+}
@@ -0,0 +1,207 @@
+package countries
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strings"
+)
+
+// Server is an http.Handler serving the v2 countries API from a dataset,
+// with every endpoint the HTTPClient calls and the fields filter.
+// Mount it at the root, or under /rest/v2 with http.StripPrefix.
+//
+// Unlike the original API, /alpha/{code} returns a list, as the HTTPClient expects.
+type Server struct {
+	countries []Country
+}
+
+// NewServer returns a new Server serving the given countries.
+func NewServer(countries []Country) *Server {
+	return &Server{countries: countries}
+}
+
+type apiError struct {
+	Status  int    `json:"status"`
+	Message string `json:"message"`
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		writeAPIError(w, http.StatusMethodNotAllowed)
+		return
+	}
+
+	parts := strings.SplitN(strings.Trim(r.URL.Path, "/"), "/", 2)
+	endpoint, value := parts[0], ""
+	if len(parts) == 2 {
+		value = parts[1]
+	}
+	query := apiQuery(r.URL.RawQuery)
+
+	var match func(Country) bool
+	switch {
+	case endpoint == "all" && value == "":
+		match = func(Country) bool { return true }
+	case endpoint == "name" && value != "":
+		name := fold(value)
+		if query.Get("fullText") == "true" {
+			match = func(c Country) bool { return fold(c.Name) == name }
+		} else {
+			match = func(c Country) bool {
+				return strings.Contains(fold(c.Name), name) || strings.Contains(fold(c.NativeName), name)
+			}
+		}
+	case endpoint == "alpha" && value != "":
+		match = codeMatcher([]string{value})
+	case endpoint == "alpha" && query.Get(codesFilter) != "":
+		match = codeMatcher(strings.Split(query.Get(codesFilter), ";"))
+	case endpoint == "capital" && value != "":
+		capital := fold(value)
+		match = func(c Country) bool { return c.Capital != "" && strings.Contains(fold(c.Capital), capital) }
+	case endpoint == "currency" && value != "":
+		match = func(c Country) bool {
+			for _, cur := range c.Currencies {
+				if strings.EqualFold(cur.Code, value) {
+					return true
+				}
+			}
+			return false
+		}
+	case endpoint == "lang" && value != "":
+		match = func(c Country) bool {
+			for _, l := range c.Languages {
+				if strings.EqualFold(l.Iso6391, value) || strings.EqualFold(l.Iso6392, value) {
+					return true
+				}
+			}
+			return false
+		}
+	case endpoint == "callingcode" && value != "":
+		match = func(c Country) bool { return containsCode(c.CallingCodes, value) }
+	case endpoint == "region" && value != "":
+		match = func(c Country) bool { return strings.EqualFold(c.Region, value) }
+	case endpoint == "regionalbloc" && value != "":
+		match = func(c Country) bool {
+			for _, b := range c.RegionalBlocs {
+				if strings.EqualFold(b.Acronym, value) {
+					return true
+				}
+			}
+			return false
+		}
+	default:
+		writeAPIError(w, http.StatusNotFound)
+		return
+	}
+
+	var result []Country
+	for _, c := range s.countries {
+		if match(c) {
+			result = append(result, c)
+		}
+	}
+	if len(result) == 0 {
+		writeAPIError(w, http.StatusNotFound)
+		return
+	}
+
+	var fields []string
+	if f := query.Get(fieldsFilter); f != "" {
+		fields = strings.Split(f, ";")
+	}
+	data, err := projectCountries(result, fields)
+	if err != nil {
+		writeAPIError(w, http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json;charset=utf-8")
+	w.Write(data)
+}
+
+// apiQuery parses the query string of a call. Values are separated by ; in the
+// API, which url.ParseQuery rejects.
+func apiQuery(raw string) url.Values {
+	query := url.Values{}
+	for _, pair := range strings.Split(raw, and) {
+		if pair == "" {
+			continue
+		}
+		kv := strings.SplitN(pair, "=", 2)
+		key, err := url.QueryUnescape(kv[0])
+		if err != nil {
+			continue
+		}
+		value := ""
+		if len(kv) == 2 {
+			if value, err = url.QueryUnescape(kv[1]); err != nil {
+				continue
+			}
+		}
+		query.Add(key, value)
+	}
+
+	return query
+}
+
+// codeMatcher matches countries by alpha-2 or alpha-3 code, ignoring case.
+func codeMatcher(codes []string) func(Country) bool {
+	return func(c Country) bool {
+		for _, code := range codes {
+			code = strings.TrimSpace(code)
+			if strings.EqualFold(c.Alpha2Code, code) || strings.EqualFold(c.Alpha3Code, code) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// projectCountries marshals the countries keeping only the given fields, in
+// the order of the Country struct. Unknown fields are ignored as the API does.
+func projectCountries(countries []Country, fields []string) ([]byte, error) {
+	if len(fields) == 0 {
+		return json.Marshal(countries)
+	}
+
+	buf := bytes.Buffer{}
+	buf.WriteByte('[')
+	for i, c := range countries {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		buf.WriteByte('{')
+		v := reflect.ValueOf(c)
+		first := true
+		for _, f := range countryFields {
+			if !containsCode(fields, f.name) {
+				continue
+			}
+			value, err := json.Marshal(v.Field(f.index).Interface())
+			if err != nil {
+				return nil, err
+			}
+			if !first {
+				buf.WriteByte(',')
+			}
+			first = false
+			key, _ := json.Marshal(f.name)
+			buf.Write(key)
+			buf.WriteByte(':')
+			buf.Write(value)
+		}
+		buf.WriteByte('}')
+	}
+	buf.WriteByte(']')
+
+	return buf.Bytes(), nil
+}
+
+func writeAPIError(w http.ResponseWriter, status int) {
+	data, _ := json.Marshal(apiError{Status: status, Message: http.StatusText(status)})
+	w.Header().Set("Content-Type", "application/json;charset=utf-8")
+	w.WriteHeader(status)
+	w.Write(data)
+}
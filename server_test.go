@@ -0,0 +1,116 @@
+package countries_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/georgesafta/countries"
+)
+
+var filteredFields = []string{"name", "alpha3Code", "capital", "region", "subregion", "population", "demonym", "area", "nativeName", "currencies", "languages", "flag"}
+
+func newTestServer(t *testing.T) (*httptest.Server, *countries.HTTPClient) {
+	ts := httptest.NewServer(countries.NewServer(loadDataset(t)))
+
+	return ts, countries.NewHTTPClient(ts.URL)
+}
+
+func alpha3Codes(c []countries.Country) string {
+	codes := make([]string, len(c))
+	for i, country := range c {
+		codes[i] = country.Alpha3Code
+	}
+
+	return strings.Join(codes, ",")
+}
+
+func TestServerEndpoints(t *testing.T) {
+	ts, client := newTestServer(t)
+	defer ts.Close()
+
+	for _, test := range []struct {
+		name     string
+		call     func(fields ...string) ([]countries.Country, error)
+		expected string
+	}{
+		{"ByName", func(f ...string) ([]countries.Country, error) { return client.ByName("colom", f...) }, "COL"},
+		{"ByName native", func(f ...string) ([]countries.Country, error) { return client.ByName("Deutschland", f...) }, "DEU"},
+		{"ByName partial", func(f ...string) ([]countries.Country, error) { return client.ByName("united", f...) }, "USA,GBR"},
+		{"ByFullName", func(f ...string) ([]countries.Country, error) { return client.ByFullName("colombia", f...) }, "COL"},
+		{"ByCode", func(f ...string) ([]countries.Country, error) { return client.ByCode("co", f...) }, "COL"},
+		{"ByCodes", func(f ...string) ([]countries.Country, error) {
+			return client.ByCodes([]string{"col", "PE", "xxx"}, f...)
+		}, "COL,PER"},
+		{"ByCapital", func(f ...string) ([]countries.Country, error) { return client.ByCapital("bogota", f...) }, "COL"},
+		{"ByCurrency", func(f ...string) ([]countries.Country, error) { return client.ByCurrency("cop", f...) }, "COL"},
+		{"ByLanguage", func(f ...string) ([]countries.Country, error) { return client.ByLanguage("de", f...) }, "DEU,AUT,CHE"},
+		{"ByCallingCode", func(f ...string) ([]countries.Country, error) { return client.ByCallingCode("1", f...) }, "USA,CAN"},
+		{"ByRegion", func(f ...string) ([]countries.Country, error) { return client.ByRegion("africa", f...) }, "SEN,CIV"},
+		{"ByRegionalBloc", func(f ...string) ([]countries.Country, error) { return client.ByRegionalBloc("pa", f...) }, "COL,PER,MEX"},
+	} {
+		resp, err := test.call()
+		if err != nil || alpha3Codes(resp) != test.expected {
+			t.Fatalf("%s: expected %s, got %s, %v", test.name, test.expected, alpha3Codes(resp), err)
+		}
+		if test.expected == "COL" && !reflect.DeepEqual(resp, expectedFullResponse) {
+			t.Fatalf("%s: expected %v, got %v", test.name, expectedFullResponse, resp)
+		}
+
+		resp, err = test.call(filteredFields...)
+		if err != nil || alpha3Codes(resp) != test.expected {
+			t.Fatalf("%s: expected filtered %s, got %s, %v", test.name, test.expected, alpha3Codes(resp), err)
+		}
+		if test.expected == "COL" && !reflect.DeepEqual(resp, expectedFilteredResponse) {
+			t.Fatalf("%s: expected %v, got %v", test.name, expectedFilteredResponse, resp)
+		}
+	}
+
+	all, err := client.All("alpha3Code")
+	if err != nil || len(all) != 29 || !reflect.DeepEqual(all[0], countries.Country{Alpha3Code: "COL"}) {
+		t.Fatalf("Unexpected projected countries %v, %v", all, err)
+	}
+}
+
+func TestServerErrors(t *testing.T) {
+	ts, client := newTestServer(t)
+	defer ts.Close()
+
+	for _, call := range []func() ([]countries.Country, error){
+		func() ([]countries.Country, error) { return client.ByName("atlantis") },
+		func() ([]countries.Country, error) { return client.ByFullName("colom") },
+		func() ([]countries.Country, error) { return client.ByCode("xx") },
+		func() ([]countries.Country, error) { return client.ByRegion("nowhere") },
+	} {
+		_, err := call()
+		if statusErr, ok := err.(*countries.StatusError); !ok || statusErr.StatusCode != http.StatusNotFound {
+			t.Fatalf("Expected not found error, got %v", err)
+		}
+	}
+
+	res, err := http.Get(ts.URL + "/unknown/endpoint")
+	if err != nil || res.StatusCode != http.StatusNotFound {
+		t.Fatalf("Expected not found status, got %v", err)
+	}
+	res.Body.Close()
+
+	res, err = http.Post(ts.URL+"/all", "application/json", nil)
+	if err != nil || res.StatusCode != http.StatusMethodNotAllowed {
+		t.Fatalf("Expected method not allowed status, got %v", err)
+	}
+	res.Body.Close()
+}
+
+func TestServerPrefix(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.Handle("/rest/v2/", http.StripPrefix("/rest/v2", countries.NewServer(loadDataset(t))))
+	ts := httptest.NewServer(mux)
+	defer ts.Close()
+
+	resp, err := countries.NewHTTPClient(ts.URL + "/rest/v2").ByName("peru")
+	if err != nil || alpha3Codes(resp) != "PER" {
+		t.Fatalf("Expected PER, got %s, %v", alpha3Codes(resp), err)
+	}
+}
@@ -0,0 +1,180 @@
+package countries
+
+import (
+	"math"
+	"sort"
+	"strings"
+)
+
+// Metric extracts a numeric value from a country.
+// Returns false when the country has no value, so it is left out of aggregations
+// instead of being counted as zero.
+type Metric func(Country) (float64, bool)
+
+// GroupKey returns the groups a country belongs to.
+// A country can belong to several groups, e.g. one per currency.
+type GroupKey func(Country) []string
+
+// Metrics over the numeric fields of a country. The API decodes missing values
+// as zero, so zero is treated as missing.
+var (
+	PopulationMetric Metric = func(c Country) (float64, bool) {
+		return float64(c.Population), c.Population > 0
+	}
+	AreaMetric Metric = func(c Country) (float64, bool) {
+		return float64(c.Area), c.Area > 0
+	}
+	GiniMetric Metric = func(c Country) (float64, bool) {
+		return float64(c.Gini), c.Gini > 0
+	}
+	// DensityMetric is the population per square kilometre.
+	DensityMetric Metric = func(c Country) (float64, bool) {
+		if c.Population <= 0 || c.Area <= 0 {
+			return 0, false
+		}
+		return float64(c.Population) / float64(c.Area), true
+	}
+)
+
+// Group keys over the fields of a country. Empty values are left out.
+var (
+	RegionKey GroupKey = func(c Country) []string {
+		return nonEmpty(c.Region)
+	}
+	SubregionKey GroupKey = func(c Country) []string {
+		return nonEmpty(c.Subregion)
+	}
+	CurrencyKey GroupKey = func(c Country) []string {
+		var keys []string
+		for _, cur := range c.Currencies {
+			keys = appendMissing(keys, currencyCode(cur))
+		}
+		return keys
+	}
+	LanguageKey GroupKey = func(c Country) []string {
+		var keys []string
+		for _, l := range c.Languages {
+			key := l.Iso6392
+			if key == "" {
+				key = l.Name
+			}
+			keys = appendMissing(keys, key)
+		}
+		return keys
+	}
+	BlocKey GroupKey = func(c Country) []string {
+		var keys []string
+		for _, b := range c.RegionalBlocs {
+			keys = appendMissing(keys, strings.ToUpper(b.Acronym))
+		}
+		return keys
+	}
+)
+
+// Summary holds the statistics of a metric over a set of countries.
+// Count is the number of countries with a value and Missing the number without one.
+type Summary struct {
+	Count   int
+	Missing int
+	Sum     float64
+	Mean    float64
+	Median  float64
+	Min     float64
+	Max     float64
+}
+
+// Ranked is a country with its value and its 1-based rank.
+type Ranked struct {
+	Rank    int
+	Value   float64
+	Country Country
+}
+
+// Summarize computes the statistics of the metric over the countries.
+// Statistics are zero when no country has a value.
+func Summarize(countries []Country, metric Metric) Summary {
+	var values []float64
+	s := Summary{}
+	for _, c := range countries {
+		v, ok := metric(c)
+		if !ok {
+			s.Missing++
+			continue
+		}
+		values = append(values, v)
+	}
+	if len(values) == 0 {
+		return s
+	}
+
+	sort.Float64s(values)
+	s.Count = len(values)
+	s.Min, s.Max = values[0], values[len(values)-1]
+	for _, v := range values {
+		s.Sum += v
+	}
+	s.Mean = s.Sum / float64(s.Count)
+	if mid := s.Count / 2; s.Count%2 == 1 {
+		s.Median = values[mid]
+	} else {
+		s.Median = (values[mid-1] + values[mid]) / 2
+	}
+
+	return s
+}
+
+// GroupBy groups the countries by key.
+func GroupBy(countries []Country, key GroupKey) map[string][]Country {
+	groups := map[string][]Country{}
+	for _, c := range countries {
+		for _, k := range key(c) {
+			groups[k] = append(groups[k], c)
+		}
+	}
+
+	return groups
+}
+
+// SummarizeBy computes the statistics of the metric for every group of countries.
+func SummarizeBy(countries []Country, key GroupKey, metric Metric) map[string]Summary {
+	summaries := map[string]Summary{}
+	for k, group := range GroupBy(countries, key) {
+		summaries[k] = Summarize(group, metric)
+	}
+
+	return summaries
+}
+
+// Top returns the n countries with the highest value of the metric, highest first.
+// Countries without a value are left out and ties share the same rank.
+// A negative n returns every country with a value.
+func Top(countries []Country, metric Metric, n int) []Ranked {
+	var ranked []Ranked
+	for _, c := range countries {
+		if v, ok := metric(c); ok && !math.IsNaN(v) {
+			ranked = append(ranked, Ranked{Value: v, Country: c})
+		}
+	}
+	sort.SliceStable(ranked, func(i, j int) bool {
+		return ranked[i].Value > ranked[j].Value
+	})
+	for i := range ranked {
+		ranked[i].Rank = i + 1
+		if i > 0 && ranked[i].Value == ranked[i-1].Value {
+			ranked[i].Rank = ranked[i-1].Rank
+		}
+	}
+	if n >= 0 && n < len(ranked) {
+		ranked = ranked[:n]
+	}
+
+	return ranked
+}
+
+func nonEmpty(s string) []string {
+	if s == "" {
+		return nil
+	}
+
+	return []string{s}
+}
@@ -0,0 +1,87 @@
+package countries_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/georgesafta/countries"
+)
+
+func TestSummarize(t *testing.T) {
+	data := []countries.Country{
+		{Alpha3Code: "AAA", Population: 10, Area: 2, Gini: 30},
+		{Alpha3Code: "BBB", Population: 30, Area: 3},
+		{Alpha3Code: "CCC", Population: 20, Gini: 40},
+		{Alpha3Code: "DDD"},
+	}
+
+	s := countries.Summarize(data, countries.PopulationMetric)
+	expected := countries.Summary{Count: 3, Missing: 1, Sum: 60, Mean: 20, Median: 20, Min: 10, Max: 30}
+	if s != expected {
+		t.Fatalf("Expected %v, got %v", expected, s)
+	}
+
+	s = countries.Summarize(data, countries.GiniMetric)
+	expected = countries.Summary{Count: 2, Missing: 2, Sum: 70, Mean: 35, Median: 35, Min: 30, Max: 40}
+	if s != expected {
+		t.Fatalf("Expected missing gini to be left out, got %v", s)
+	}
+
+	s = countries.Summarize(data, countries.DensityMetric)
+	if s.Count != 2 || s.Missing != 2 || s.Mean != 7.5 {
+		t.Fatalf("Unexpected density summary %v", s)
+	}
+
+	if s := countries.Summarize(nil, countries.AreaMetric); s != (countries.Summary{}) {
+		t.Fatalf("Expected empty summary, got %v", s)
+	}
+}
+
+func TestSummarizeBy(t *testing.T) {
+	data := loadDataset(t)
+
+	byRegion := countries.SummarizeBy(data, countries.RegionKey, countries.PopulationMetric)
+	if len(byRegion) != 6 {
+		t.Fatalf("Expected 6 regions, got %v", byRegion)
+	}
+	if s := byRegion["Africa"]; s.Count != 2 || s.Sum != 14799859+22671331 {
+		t.Fatalf("Unexpected Africa summary %v", s)
+	}
+
+	byCurrency := countries.SummarizeBy(data, countries.CurrencyKey, countries.GiniMetric)
+	if s := byCurrency["AUD"]; s.Count != 1 || s.Missing != 2 || s.Mean != 30.5 {
+		t.Fatalf("Unexpected AUD gini summary %v", s)
+	}
+
+	byBloc := countries.GroupBy(data, countries.BlocKey)
+	if len(byBloc["USAN"]) != 6 || len(byBloc["PA"]) != 3 {
+		t.Fatalf("Unexpected bloc groups %d, %d", len(byBloc["USAN"]), len(byBloc["PA"]))
+	}
+
+	byLanguage := countries.GroupBy(data, countries.LanguageKey)
+	if len(byLanguage["spa"]) != 9 {
+		t.Fatalf("Expected 9 spanish speaking countries, got %d", len(byLanguage["spa"]))
+	}
+}
+
+func TestTop(t *testing.T) {
+	data := loadDataset(t)
+
+	top := countries.Top(data, countries.PopulationMetric, 3)
+	if len(top) != 3 || top[0].Country.Alpha3Code != "CHN" || top[1].Country.Alpha3Code != "IND" || top[2].Country.Alpha3Code != "USA" {
+		t.Fatalf("Unexpected top population %v", top)
+	}
+
+	density := countries.Top(data, countries.DensityMetric, -1)
+	if density[0].Country.Alpha3Code != "KOR" || math.Abs(density[0].Value-506.95) > 0.01 {
+		t.Fatalf("Expected South Korea to be the densest, got %s %v", density[0].Country.Alpha3Code, density[0].Value)
+	}
+	if len(density) != len(data) {
+		t.Fatalf("Expected every country to have a density, got %d", len(density))
+	}
+
+	tied := countries.Top([]countries.Country{{Gini: 30}, {Gini: 40}, {Gini: 30}, {}}, countries.GiniMetric, 10)
+	if len(tied) != 3 || tied[0].Rank != 1 || tied[1].Rank != 2 || tied[2].Rank != 2 {
+		t.Fatalf("Unexpected ranks %v", tied)
+	}
+}
@@ -0,0 +1,817 @@
+package countries
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"image"
+	"image/color"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// svgSubsamples is the number of scanlines sampled per pixel row.
+const svgSubsamples = 4
+
+// curveSegments is the number of segments a bezier curve is flattened into.
+const curveSegments = 16
+
+// maxSVGPixels is the largest image rendered, in pixels, whether its size is
+// requested or declared by the document.
+const maxSVGPixels = 1 << 24
+
+// maxSVGNodes is the number of elements rendered at most, including the ones
+// expanded by <use> elements, so nested references cannot fan out without bound.
+const maxSVGNodes = 1 << 16
+
+// namedColors holds the SVG color keywords most used by flags.
+var namedColors = map[string]color.NRGBA{
+	"black":   {0, 0, 0, 255},
+	"white":   {255, 255, 255, 255},
+	"red":     {255, 0, 0, 255},
+	"green":   {0, 128, 0, 255},
+	"blue":    {0, 0, 255, 255},
+	"yellow":  {255, 255, 0, 255},
+	"orange":  {255, 165, 0, 255},
+	"gold":    {255, 215, 0, 255},
+	"navy":    {0, 0, 128, 255},
+	"maroon":  {128, 0, 0, 255},
+	"purple":  {128, 0, 128, 255},
+	"gray":    {128, 128, 128, 255},
+	"grey":    {128, 128, 128, 255},
+	"silver":  {192, 192, 192, 255},
+	"lime":    {0, 255, 0, 255},
+	"aqua":    {0, 255, 255, 255},
+	"cyan":    {0, 255, 255, 255},
+	"fuchsia": {255, 0, 255, 255},
+	"magenta": {255, 0, 255, 255},
+	"teal":    {0, 128, 128, 255},
+	"olive":   {128, 128, 0, 255},
+}
+
+type svgNode struct {
+	name     string
+	attrs    map[string]string
+	children []*svgNode
+}
+
+type point struct {
+	x, y float64
+}
+
+// matrix is an affine transform [a c e; b d f].
+type matrix [6]float64
+
+var identity = matrix{1, 0, 0, 1, 0, 0}
+
+func (m matrix) multiply(n matrix) matrix {
+	return matrix{
+		m[0]*n[0] + m[2]*n[1],
+		m[1]*n[0] + m[3]*n[1],
+		m[0]*n[2] + m[2]*n[3],
+		m[1]*n[2] + m[3]*n[3],
+		m[0]*n[4] + m[2]*n[5] + m[4],
+		m[1]*n[4] + m[3]*n[5] + m[5],
+	}
+}
+
+func (m matrix) apply(p point) point {
+	return point{m[0]*p.x + m[2]*p.y + m[4], m[1]*p.x + m[3]*p.y + m[5]}
+}
+
+type svgStyle struct {
+	fill     *color.NRGBA
+	opacity  float64
+	evenOdd  bool
+	viewport matrix
+}
+
+// svgImage is a parsed SVG document.
+type svgImage struct {
+	root   *svgNode
+	ids    map[string]*svgNode
+	width  float64
+	height float64
+	box    [4]float64
+}
+
+// parseSVG parses an SVG document, keeping its element tree.
+func parseSVG(data []byte) (*svgImage, error) {
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+	decoder.Strict = false
+	img := &svgImage{ids: map[string]*svgNode{}}
+	var stack []*svgNode
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("Invalid SVG: %v", err)
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			node := &svgNode{name: t.Name.Local, attrs: map[string]string{}}
+			for _, a := range t.Attr {
+				node.attrs[a.Name.Local] = a.Value
+			}
+			if id := node.attrs["id"]; id != "" {
+				img.ids[id] = node
+			}
+			if len(stack) > 0 {
+				parent := stack[len(stack)-1]
+				parent.children = append(parent.children, node)
+			} else if img.root == nil {
+				img.root = node
+			}
+			stack = append(stack, node)
+		case xml.EndElement:
+			if len(stack) > 0 {
+				stack = stack[:len(stack)-1]
+			}
+		}
+	}
+	if img.root == nil || img.root.name != "svg" {
+		return nil, fmt.Errorf("Invalid SVG: missing svg root element")
+	}
+
+	img.width = parseLength(img.root.attrs["width"])
+	img.height = parseLength(img.root.attrs["height"])
+	if box := parseNumbers(img.root.attrs["viewBox"]); len(box) == 4 && box[2] > 0 && box[3] > 0 {
+		copy(img.box[:], box)
+	} else {
+		img.box = [4]float64{0, 0, img.width, img.height}
+	}
+	if img.width <= 0 {
+		img.width = img.box[2]
+	}
+	if img.height <= 0 {
+		img.height = img.box[3]
+	}
+	if img.width <= 0 || img.height <= 0 {
+		return nil, fmt.Errorf("Invalid SVG: missing dimensions")
+	}
+
+	return img, nil
+}
+
+// rasterizeSVG renders an SVG document at the given size.
+// When one of the dimensions is zero it is derived from the aspect ratio of the document.
+// Only filled shapes are rendered: strokes, gradients, text, clipping and masks are ignored.
+func rasterizeSVG(data []byte, width, height int) (*image.NRGBA, error) {
+	img, err := parseSVG(data)
+	if err != nil {
+		return nil, err
+	}
+	// Sizes are computed as floats, so huge declared sizes can't overflow.
+	w, h := float64(width), float64(height)
+	switch {
+	case width <= 0 && height <= 0:
+		w, h = math.Round(img.width), math.Round(img.height)
+	case width <= 0:
+		w = math.Round(h * img.width / img.height)
+	case height <= 0:
+		h = math.Round(w * img.height / img.width)
+	}
+	if !(w >= 1 && h >= 1) {
+		return nil, fmt.Errorf("Invalid image size %.0fx%.0f", w, h)
+	}
+	if w*h > maxSVGPixels {
+		return nil, fmt.Errorf("Image size %.0fx%.0f exceeds %d pixels", w, h, maxSVGPixels)
+	}
+	width, height = int(w), int(h)
+
+	// The view box is scaled uniformly and centred, as for preserveAspectRatio xMidYMid meet.
+	scale := math.Min(float64(width)/img.box[2], float64(height)/img.box[3])
+	dx := (float64(width) - img.box[2]*scale) / 2
+	dy := (float64(height) - img.box[3]*scale) / 2
+	view := matrix{scale, 0, 0, scale, dx - img.box[0]*scale, dy - img.box[1]*scale}
+
+	canvas := image.NewNRGBA(image.Rect(0, 0, width, height))
+	black := namedColors["black"]
+	r := &svgRenderer{svgImage: img, canvas: canvas, expanding: map[string]bool{}}
+	r.render(img.root, svgStyle{fill: &black, opacity: 1, viewport: view}, 0)
+
+	return canvas, nil
+}
+
+// svgRenderer is the state of a rendering.
+type svgRenderer struct {
+	*svgImage
+	canvas *image.NRGBA
+	// expanding holds the ids of the elements being rendered or expanded, so
+	// <use> elements referencing them are skipped.
+	expanding map[string]bool
+	nodes     int
+}
+
+func (r *svgRenderer) render(node *svgNode, style svgStyle, depth int) {
+	if depth > 32 || r.nodes >= maxSVGNodes {
+		return
+	}
+	r.nodes++
+	if id := node.attrs["id"]; id != "" && !r.expanding[id] {
+		r.expanding[id] = true
+		defer delete(r.expanding, id)
+	}
+
+	style = inheritStyle(node, style)
+	if t, ok := node.attrs["transform"]; ok {
+		style.viewport = style.viewport.multiply(parseTransform(t))
+	}
+
+	switch node.name {
+	case "defs", "clipPath", "mask", "symbol", "linearGradient", "radialGradient", "pattern", "title", "metadata", "text":
+		return
+	case "use":
+		id := strings.TrimPrefix(href(node), "#")
+		target := r.ids[id]
+		if target == nil || r.expanding[id] {
+			return
+		}
+		r.expanding[id] = true
+		defer delete(r.expanding, id)
+		x, y := parseLength(node.attrs["x"]), parseLength(node.attrs["y"])
+		style.viewport = style.viewport.multiply(matrix{1, 0, 0, 1, x, y})
+		if target.name == "symbol" {
+			for _, child := range target.children {
+				r.render(child, inheritStyle(target, style), depth+1)
+			}
+			return
+		}
+		r.render(target, style, depth+1)
+		return
+	}
+
+	if polygons := shape(node); len(polygons) > 0 && style.fill != nil && style.opacity > 0 {
+		for _, poly := range polygons {
+			for i := range poly {
+				poly[i] = style.viewport.apply(poly[i])
+			}
+		}
+		fillPolygons(r.canvas, polygons, *style.fill, style.opacity, style.evenOdd)
+	}
+	for _, child := range node.children {
+		r.render(child, style, depth+1)
+	}
+}
+
+// inheritStyle applies the presentation attributes and style of the node.
+func inheritStyle(node *svgNode, style svgStyle) svgStyle {
+	properties := map[string]string{}
+	for _, name := range []string{"fill", "fill-rule", "fill-opacity", "opacity", "display", "visibility"} {
+		if v, ok := node.attrs[name]; ok {
+			properties[name] = v
+		}
+	}
+	for _, declaration := range strings.Split(node.attrs["style"], ";") {
+		if kv := strings.SplitN(declaration, ":", 2); len(kv) == 2 {
+			properties[strings.TrimSpace(kv[0])] = strings.TrimSpace(kv[1])
+		}
+	}
+
+	if v, ok := properties["fill"]; ok {
+		if c, ok := parseColor(v); ok {
+			style.fill = &c
+		} else if strings.TrimSpace(v) == "none" {
+			style.fill = nil
+		}
+	}
+	if v, ok := properties["fill-rule"]; ok {
+		style.evenOdd = strings.TrimSpace(v) == "evenodd"
+	}
+	for _, name := range []string{"fill-opacity", "opacity"} {
+		if v, ok := properties[name]; ok {
+			if o, err := strconv.ParseFloat(strings.TrimSpace(v), 64); err == nil {
+				style.opacity *= math.Max(0, math.Min(1, o))
+			}
+		}
+	}
+	if properties["display"] == "none" || properties["visibility"] == "hidden" {
+		style.opacity = 0
+	}
+
+	return style
+}
+
+func href(node *svgNode) string {
+	// encoding/xml drops the xlink prefix, keeping only the local name.
+	return node.attrs["href"]
+}
+
+// shape returns the outline of a shape element as closed polygons, in user space.
+func shape(node *svgNode) [][]point {
+	a := func(name string) float64 {
+		return parseLength(node.attrs[name])
+	}
+
+	switch node.name {
+	case "rect":
+		x, y, w, h := a("x"), a("y"), a("width"), a("height")
+		if w <= 0 || h <= 0 {
+			return nil
+		}
+		return [][]point{{{x, y}, {x + w, y}, {x + w, y + h}, {x, y + h}}}
+	case "circle":
+		return [][]point{ellipse(a("cx"), a("cy"), a("r"), a("r"))}
+	case "ellipse":
+		return [][]point{ellipse(a("cx"), a("cy"), a("rx"), a("ry"))}
+	case "polygon", "polyline":
+		numbers := parseNumbers(node.attrs["points"])
+		var poly []point
+		for i := 0; i+1 < len(numbers); i += 2 {
+			poly = append(poly, point{numbers[i], numbers[i+1]})
+		}
+		if len(poly) < 3 {
+			return nil
+		}
+		return [][]point{poly}
+	case "path":
+		return parsePath(node.attrs["d"])
+	}
+
+	return nil
+}
+
+func ellipse(cx, cy, rx, ry float64) []point {
+	if rx <= 0 || ry <= 0 {
+		return nil
+	}
+
+	const segments = 64
+	poly := make([]point, segments)
+	for i := range poly {
+		angle := 2 * math.Pi * float64(i) / segments
+		poly[i] = point{cx + rx*math.Cos(angle), cy + ry*math.Sin(angle)}
+	}
+
+	return poly
+}
+
+// fillPolygons composites the polygons onto the canvas, anti-aliased
+// horizontally by exact coverage and vertically by subsampling.
+func fillPolygons(canvas *image.NRGBA, polygons [][]point, c color.NRGBA, opacity float64, evenOdd bool) {
+	type edge struct {
+		x0, y0, x1, y1 float64
+		dir            int
+	}
+	var edges []edge
+	minY, maxY := math.Inf(1), math.Inf(-1)
+	for _, poly := range polygons {
+		for i := range poly {
+			p, q := poly[i], poly[(i+1)%len(poly)]
+			if p.y == q.y {
+				continue
+			}
+			dir := 1
+			if p.y > q.y {
+				p, q, dir = q, p, -1
+			}
+			edges = append(edges, edge{p.x, p.y, q.x, q.y, dir})
+			minY, maxY = math.Min(minY, p.y), math.Max(maxY, q.y)
+		}
+	}
+	if len(edges) == 0 {
+		return
+	}
+
+	bounds := canvas.Bounds()
+	startRow := int(math.Max(math.Floor(minY), float64(bounds.Min.Y)))
+	endRow := int(math.Min(math.Ceil(maxY), float64(bounds.Max.Y)))
+	coverage := make([]float64, bounds.Dx())
+	type crossing struct {
+		x   float64
+		dir int
+	}
+	for row := startRow; row < endRow; row++ {
+		for i := range coverage {
+			coverage[i] = 0
+		}
+		for s := 0; s < svgSubsamples; s++ {
+			y := float64(row) + (float64(s)+0.5)/svgSubsamples
+			var crossings []crossing
+			for _, e := range edges {
+				if y >= e.y0 && y < e.y1 {
+					x := e.x0 + (y-e.y0)*(e.x1-e.x0)/(e.y1-e.y0)
+					crossings = append(crossings, crossing{x, e.dir})
+				}
+			}
+			sort.Slice(crossings, func(i, j int) bool {
+				return crossings[i].x < crossings[j].x
+			})
+			winding := 0
+			for i := 0; i+1 < len(crossings); i++ {
+				winding += crossings[i].dir
+				inside := winding != 0
+				if evenOdd {
+					inside = (i+1)%2 == 1
+				}
+				if inside {
+					addSpan(coverage, crossings[i].x-float64(bounds.Min.X), crossings[i+1].x-float64(bounds.Min.X))
+				}
+			}
+		}
+
+		for i, cov := range coverage {
+			alpha := math.Min(1, cov/svgSubsamples) * opacity * float64(c.A) / 255
+			if alpha > 0 {
+				blend(canvas, bounds.Min.X+i, row, c, alpha)
+			}
+		}
+	}
+}
+
+// addSpan adds the horizontal coverage of the span [x0, x1) to each pixel.
+func addSpan(coverage []float64, x0, x1 float64) {
+	x0, x1 = math.Max(x0, 0), math.Min(x1, float64(len(coverage)))
+	for x0 < x1 {
+		pixel := math.Floor(x0)
+		end := math.Min(pixel+1, x1)
+		coverage[int(pixel)] += end - x0
+		x0 = end
+	}
+}
+
+// blend composites the color with the given alpha over the pixel.
+func blend(canvas *image.NRGBA, x, y int, c color.NRGBA, alpha float64) {
+	dst := canvas.NRGBAAt(x, y)
+	dstAlpha := float64(dst.A) / 255
+	outAlpha := alpha + dstAlpha*(1-alpha)
+	mix := func(src, dst uint8) uint8 {
+		v := (float64(src)*alpha + float64(dst)*dstAlpha*(1-alpha)) / outAlpha
+		return uint8(math.Round(math.Max(0, math.Min(255, v))))
+	}
+	canvas.SetNRGBA(x, y, color.NRGBA{
+		R: mix(c.R, dst.R),
+		G: mix(c.G, dst.G),
+		B: mix(c.B, dst.B),
+		A: uint8(math.Round(outAlpha * 255)),
+	})
+}
+
+// parseColor parses #rgb, #rrggbb, rgb() and named colors.
+func parseColor(s string) (color.NRGBA, bool) {
+	s = strings.ToLower(strings.TrimSpace(s))
+	if c, ok := namedColors[s]; ok {
+		return c, true
+	}
+	if strings.HasPrefix(s, "#") {
+		hex := s[1:]
+		if len(hex) == 3 {
+			hex = string([]byte{hex[0], hex[0], hex[1], hex[1], hex[2], hex[2]})
+		}
+		if len(hex) != 6 {
+			return color.NRGBA{}, false
+		}
+		v, err := strconv.ParseUint(hex, 16, 32)
+		if err != nil {
+			return color.NRGBA{}, false
+		}
+		return color.NRGBA{R: uint8(v >> 16), G: uint8(v >> 8), B: uint8(v), A: 255}, true
+	}
+	if strings.HasPrefix(s, "rgb(") && strings.HasSuffix(s, ")") {
+		parts := strings.Split(s[4:len(s)-1], ",")
+		if len(parts) != 3 {
+			return color.NRGBA{}, false
+		}
+		var rgb [3]uint8
+		for i, p := range parts {
+			p = strings.TrimSpace(p)
+			scale := 1.0
+			if strings.HasSuffix(p, "%") {
+				p, scale = strings.TrimSuffix(p, "%"), 2.55
+			}
+			v, err := strconv.ParseFloat(p, 64)
+			if err != nil {
+				return color.NRGBA{}, false
+			}
+			rgb[i] = uint8(math.Round(math.Max(0, math.Min(255, v*scale))))
+		}
+		return color.NRGBA{R: rgb[0], G: rgb[1], B: rgb[2], A: 255}, true
+	}
+
+	return color.NRGBA{}, false
+}
+
+// parseLength parses a length, ignoring its unit.
+func parseLength(s string) float64 {
+	s = strings.TrimSpace(s)
+	end := 0
+	for end < len(s) && strings.IndexByte("+-.0123456789eE", s[end]) >= 0 {
+		end++
+	}
+	v, err := strconv.ParseFloat(s[:end], 64)
+	if err != nil {
+		return 0
+	}
+
+	return v
+}
+
+// parseNumbers parses a list of numbers separated by spaces or commas.
+func parseNumbers(s string) []float64 {
+	var numbers []float64
+	scanner := numberScanner{s: s}
+	for {
+		v, ok := scanner.next()
+		if !ok {
+			return numbers
+		}
+		numbers = append(numbers, v)
+	}
+}
+
+// parseTransform parses a transform attribute into a matrix.
+func parseTransform(s string) matrix {
+	m := identity
+	for _, part := range strings.Split(s, ")") {
+		kv := strings.SplitN(part, "(", 2)
+		if len(kv) != 2 {
+			continue
+		}
+		name := strings.TrimSpace(strings.Trim(kv[0], ", "))
+		args := parseNumbers(kv[1])
+		arg := func(i int, fallback float64) float64 {
+			if i < len(args) {
+				return args[i]
+			}
+			return fallback
+		}
+
+		var t matrix
+		switch name {
+		case "matrix":
+			if len(args) != 6 {
+				continue
+			}
+			copy(t[:], args)
+		case "translate":
+			t = matrix{1, 0, 0, 1, arg(0, 0), arg(1, 0)}
+		case "scale":
+			sx := arg(0, 1)
+			t = matrix{sx, 0, 0, arg(1, sx), 0, 0}
+		case "rotate":
+			angle := arg(0, 0) * math.Pi / 180
+			cos, sin := math.Cos(angle), math.Sin(angle)
+			cx, cy := arg(1, 0), arg(2, 0)
+			t = matrix{1, 0, 0, 1, cx, cy}.multiply(matrix{cos, sin, -sin, cos, 0, 0}).multiply(matrix{1, 0, 0, 1, -cx, -cy})
+		case "skewX":
+			t = matrix{1, 0, math.Tan(arg(0, 0) * math.Pi / 180), 1, 0, 0}
+		case "skewY":
+			t = matrix{1, math.Tan(arg(0, 0) * math.Pi / 180), 0, 1, 0, 0}
+		default:
+			continue
+		}
+		m = m.multiply(t)
+	}
+
+	return m
+}
+
+// parsePath flattens path data into closed polygons.
+func parsePath(d string) [][]point {
+	var polygons [][]point
+	var current []point
+	var cursor, start, control point
+	var previous byte
+	scanner := numberScanner{s: d}
+
+	flush := func() {
+		if len(current) >= 3 {
+			polygons = append(polygons, current)
+		}
+		current = nil
+	}
+	lineTo := func(p point) {
+		if len(current) == 0 {
+			current = append(current, cursor)
+		}
+		current = append(current, p)
+		cursor = p
+	}
+
+	command := byte(0)
+	for {
+		position := scanner.i
+		if c, ok := scanner.command(); ok {
+			command = c
+		} else if command == 0 || !scanner.more() {
+			break
+		}
+		relative := command >= 'a' && command <= 'z'
+		offset := func(p point) point {
+			if relative {
+				return point{cursor.x + p.x, cursor.y + p.y}
+			}
+			return p
+		}
+		num := func() float64 {
+			v, _ := scanner.next()
+			return v
+		}
+		pt := func() point {
+			x := num()
+			return point{x, num()}
+		}
+
+		upper := command &^ 0x20
+		switch upper {
+		case 'M':
+			flush()
+			cursor = offset(pt())
+			start = cursor
+			// Further coordinate pairs are implicit line commands.
+			if relative {
+				command = 'l'
+			} else {
+				command = 'L'
+			}
+		case 'L':
+			lineTo(offset(pt()))
+		case 'H':
+			x := num()
+			if relative {
+				x += cursor.x
+			}
+			lineTo(point{x, cursor.y})
+		case 'V':
+			y := num()
+			if relative {
+				y += cursor.y
+			}
+			lineTo(point{cursor.x, y})
+		case 'C', 'S':
+			p0 := cursor
+			var c1 point
+			if upper == 'C' {
+				c1 = offset(pt())
+			} else if previous == 'C' || previous == 'S' {
+				c1 = point{2*cursor.x - control.x, 2*cursor.y - control.y}
+			} else {
+				c1 = cursor
+			}
+			c2, p := offset(pt()), offset(pt())
+			for i := 1; i <= curveSegments; i++ {
+				t := float64(i) / curveSegments
+				u := 1 - t
+				lineTo(point{
+					u*u*u*p0.x + 3*u*u*t*c1.x + 3*u*t*t*c2.x + t*t*t*p.x,
+					u*u*u*p0.y + 3*u*u*t*c1.y + 3*u*t*t*c2.y + t*t*t*p.y,
+				})
+			}
+			control = c2
+		case 'Q', 'T':
+			p0 := cursor
+			var c1 point
+			if upper == 'Q' {
+				c1 = offset(pt())
+			} else if previous == 'Q' || previous == 'T' {
+				c1 = point{2*cursor.x - control.x, 2*cursor.y - control.y}
+			} else {
+				c1 = cursor
+			}
+			p := offset(pt())
+			for i := 1; i <= curveSegments; i++ {
+				t := float64(i) / curveSegments
+				u := 1 - t
+				lineTo(point{u*u*p0.x + 2*u*t*c1.x + t*t*p.x, u*u*p0.y + 2*u*t*c1.y + t*t*p.y})
+			}
+			control = c1
+		case 'A':
+			rx, ry, rotation := num(), num(), num()
+			large, sweep := num() != 0, num() != 0
+			p := offset(pt())
+			for _, q := range arc(cursor, p, rx, ry, rotation, large, sweep) {
+				lineTo(q)
+			}
+		case 'Z':
+			flush()
+			cursor = start
+		default:
+			return polygons
+		}
+		previous = upper
+		// Stops on malformed data rather than repeating a command forever.
+		if scanner.i == position {
+			break
+		}
+	}
+	flush()
+
+	return polygons
+}
+
+// arc flattens an elliptical arc, following the SVG implementation notes.
+func arc(p0, p1 point, rx, ry, rotation float64, large, sweep bool) []point {
+	rx, ry = math.Abs(rx), math.Abs(ry)
+	if rx == 0 || ry == 0 || p0 == p1 {
+		return []point{p1}
+	}
+
+	phi := rotation * math.Pi / 180
+	cos, sin := math.Cos(phi), math.Sin(phi)
+	dx, dy := (p0.x-p1.x)/2, (p0.y-p1.y)/2
+	x1 := cos*dx + sin*dy
+	y1 := -sin*dx + cos*dy
+	if l := x1*x1/(rx*rx) + y1*y1/(ry*ry); l > 1 {
+		rx, ry = rx*math.Sqrt(l), ry*math.Sqrt(l)
+	}
+
+	num := rx*rx*ry*ry - rx*rx*y1*y1 - ry*ry*x1*x1
+	den := rx*rx*y1*y1 + ry*ry*x1*x1
+	coef := math.Sqrt(math.Max(0, num/den))
+	if large == sweep {
+		coef = -coef
+	}
+	cx1, cy1 := coef*rx*y1/ry, -coef*ry*x1/rx
+	cx := cos*cx1 - sin*cy1 + (p0.x+p1.x)/2
+	cy := sin*cx1 + cos*cy1 + (p0.y+p1.y)/2
+
+	angle := func(ux, uy, vx, vy float64) float64 {
+		return math.Atan2(ux*vy-uy*vx, ux*vx+uy*vy)
+	}
+	theta := angle(1, 0, (x1-cx1)/rx, (y1-cy1)/ry)
+	delta := angle((x1-cx1)/rx, (y1-cy1)/ry, (-x1-cx1)/rx, (-y1-cy1)/ry)
+	if !sweep && delta > 0 {
+		delta -= 2 * math.Pi
+	} else if sweep && delta < 0 {
+		delta += 2 * math.Pi
+	}
+
+	points := make([]point, curveSegments)
+	for i := range points {
+		t := theta + delta*float64(i+1)/curveSegments
+		x, y := rx*math.Cos(t), ry*math.Sin(t)
+		points[i] = point{cos*x - sin*y + cx, sin*x + cos*y + cy}
+	}
+	points[len(points)-1] = p1
+
+	return points
+}
+
+// numberScanner reads numbers and commands from SVG attribute values.
+type numberScanner struct {
+	s string
+	i int
+}
+
+func (n *numberScanner) skip() {
+	for n.i < len(n.s) && strings.IndexByte(" \t\r\n,", n.s[n.i]) >= 0 {
+		n.i++
+	}
+}
+
+func (n *numberScanner) more() bool {
+	n.skip()
+	return n.i < len(n.s)
+}
+
+// command reads a path command letter, if the next token is one.
+func (n *numberScanner) command() (byte, bool) {
+	n.skip()
+	if n.i < len(n.s) && strings.IndexByte("MmLlHhVvCcSsQqTtAaZz", n.s[n.i]) >= 0 {
+		n.i++
+		return n.s[n.i-1], true
+	}
+
+	return 0, false
+}
+
+// next reads a number, where a sign or a second dot starts the next one.
+func (n *numberScanner) next() (float64, bool) {
+	n.skip()
+	start := n.i
+	if n.i < len(n.s) && (n.s[n.i] == '+' || n.s[n.i] == '-') {
+		n.i++
+	}
+	dot, digits := false, false
+	for n.i < len(n.s) {
+		c := n.s[n.i]
+		switch {
+		case c >= '0' && c <= '9':
+			digits = true
+		case c == '.' && !dot:
+			dot = true
+		case (c == 'e' || c == 'E') && digits && n.i+1 < len(n.s) && strings.IndexByte("+-0123456789", n.s[n.i+1]) >= 0:
+			n.i++
+		default:
+			if !digits {
+				n.i = start
+				return 0, false
+			}
+			v, err := strconv.ParseFloat(n.s[start:n.i], 64)
+			return v, err == nil
+		}
+		n.i++
+	}
+	if !digits {
+		n.i = start
+		return 0, false
+	}
+	v, err := strconv.ParseFloat(n.s[start:n.i], 64)
+
+	return v, err == nil
+}
@@ -0,0 +1,237 @@
+package countries
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	utcPrefix = "UTC"
+	day       = 24 * time.Hour
+	minOffset = -12 * Offset(time.Hour)
+	maxOffset = 14 * Offset(time.Hour)
+)
+
+// Offset is a fixed offset from UTC, as listed in Country.Timezones.
+type Offset time.Duration
+
+// TimezoneError reports a malformed entry of Country.Timezones.
+type TimezoneError struct {
+	Country string
+	Value   string
+	Reason  string
+}
+
+func (e *TimezoneError) Error() string {
+	if e.Country == "" {
+		return fmt.Sprintf("Invalid timezone %q: %s", e.Value, e.Reason)
+	}
+
+	return fmt.Sprintf("Invalid timezone %q for %s: %s", e.Value, e.Country, e.Reason)
+}
+
+// BusinessHours is a daily working window, as durations since local midnight.
+type BusinessHours struct {
+	Start time.Duration
+	End   time.Duration
+}
+
+// DefaultBusinessHours goes from 9:00 to 17:00.
+var DefaultBusinessHours = BusinessHours{Start: 9 * time.Hour, End: 17 * time.Hour}
+
+// HoursOverlap is the time a country's business hours overlap with an office.
+type HoursOverlap struct {
+	Country Country
+	Offset  Offset
+	Overlap time.Duration
+}
+
+// ParseOffset parses a timezone such as UTC, UTC-05:00 or UTC+05:45.
+func ParseOffset(s string) (Offset, error) {
+	value := strings.TrimSpace(s)
+	if !strings.HasPrefix(value, utcPrefix) {
+		return 0, &TimezoneError{Value: s, Reason: "missing UTC prefix"}
+	}
+	value = value[len(utcPrefix):]
+	if value == "" {
+		return 0, nil
+	}
+
+	sign := Offset(1)
+	switch value[0] {
+	case '+':
+	case '-':
+		sign = -1
+	default:
+		return 0, &TimezoneError{Value: s, Reason: "missing offset sign"}
+	}
+	parts := strings.Split(value[1:], ":")
+	if len(parts) != 2 || len(parts[0]) != 2 || len(parts[1]) != 2 {
+		return 0, &TimezoneError{Value: s, Reason: "offset must be formatted as ±HH:MM"}
+	}
+	// Atoi alone would accept signs, e.g. in UTC+-5:00.
+	if !isDigits(parts[0]) {
+		return 0, &TimezoneError{Value: s, Reason: "invalid hours"}
+	}
+	hours, _ := strconv.Atoi(parts[0])
+	minutes, _ := strconv.Atoi(parts[1])
+	if !isDigits(parts[1]) || minutes > 59 {
+		return 0, &TimezoneError{Value: s, Reason: "invalid minutes"}
+	}
+
+	offset := sign * (Offset(hours)*Offset(time.Hour) + Offset(minutes)*Offset(time.Minute))
+	if offset < minOffset || offset > maxOffset {
+		return 0, &TimezoneError{Value: s, Reason: "offset out of range"}
+	}
+
+	return offset, nil
+}
+
+// String formats the offset the way the countries API does.
+func (o Offset) String() string {
+	if o == 0 {
+		return utcPrefix
+	}
+
+	sign := '+'
+	if o < 0 {
+		sign = '-'
+		o = -o
+	}
+	minutes := int(time.Duration(o) / time.Minute)
+
+	return fmt.Sprintf("%s%c%02d:%02d", utcPrefix, sign, minutes/60, minutes%60)
+}
+
+// Location returns a fixed time zone for the offset.
+func (o Offset) Location() *time.Location {
+	return time.FixedZone(o.String(), int(time.Duration(o)/time.Second))
+}
+
+// Offsets parses the timezones of the country.
+// Returns a *TimezoneError on the first malformed entry.
+func (c Country) Offsets() ([]Offset, error) {
+	offsets := make([]Offset, 0, len(c.Timezones))
+	for _, tz := range c.Timezones {
+		o, err := ParseOffset(tz)
+		if err != nil {
+			e := err.(*TimezoneError)
+			e.Country = c.Alpha3Code
+			return nil, e
+		}
+		offsets = append(offsets, o)
+	}
+
+	return offsets, nil
+}
+
+// LocalTimes returns the given instant in every timezone of the country.
+func (c Country) LocalTimes(t time.Time) ([]time.Time, error) {
+	offsets, err := c.Offsets()
+	if err != nil {
+		return nil, err
+	}
+
+	times := make([]time.Time, len(offsets))
+	for i, o := range offsets {
+		times[i] = t.In(o.Location())
+	}
+
+	return times, nil
+}
+
+// ValidateTimezones returns an error for every malformed timezone of the countries.
+func ValidateTimezones(countries []Country) []*TimezoneError {
+	var errs []*TimezoneError
+	for _, c := range countries {
+		for _, tz := range c.Timezones {
+			if _, err := ParseOffset(tz); err != nil {
+				e := err.(*TimezoneError)
+				e.Country = c.Alpha3Code
+				errs = append(errs, e)
+			}
+		}
+	}
+
+	return errs
+}
+
+// InOffsetRange returns the countries having at least one timezone between min and max, inclusive.
+// Malformed timezones are skipped, use ValidateTimezones to report them.
+func InOffsetRange(countries []Country, min, max Offset) []Country {
+	var result []Country
+	for _, c := range countries {
+		for _, o := range validOffsets(c) {
+			if o >= min && o <= max {
+				result = append(result, c)
+				break
+			}
+		}
+	}
+
+	return result
+}
+
+// BusinessHoursOverlap returns the countries whose business hours overlap with
+// those of an office at the given offset, longest overlap first.
+// Every country uses the same business hours as the office, and the timezone
+// giving the longest overlap is kept for countries spanning several.
+// Malformed timezones are skipped, use ValidateTimezones to report them.
+func BusinessHoursOverlap(countries []Country, office Offset, hours BusinessHours) []HoursOverlap {
+	var result []HoursOverlap
+	for _, c := range countries {
+		best := HoursOverlap{Country: c}
+		for _, o := range validOffsets(c) {
+			if overlap := windowOverlap(hours, office, o); overlap > best.Overlap {
+				best.Offset = o
+				best.Overlap = overlap
+			}
+		}
+		if best.Overlap > 0 {
+			result = append(result, best)
+		}
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].Overlap > result[j].Overlap
+	})
+
+	return result
+}
+
+func validOffsets(c Country) []Offset {
+	var offsets []Offset
+	for _, tz := range c.Timezones {
+		if o, err := ParseOffset(tz); err == nil {
+			offsets = append(offsets, o)
+		}
+	}
+
+	return offsets
+}
+
+// windowOverlap returns how long the same local business hours overlap
+// between two offsets, looking at the previous, same and next day.
+func windowOverlap(hours BusinessHours, a, b Offset) time.Duration {
+	startA := hours.Start - time.Duration(a)
+	endA := hours.End - time.Duration(a)
+	var best time.Duration
+	for _, shift := range []time.Duration{-day, 0, day} {
+		startB := hours.Start - time.Duration(b) + shift
+		endB := hours.End - time.Duration(b) + shift
+		start, end := startA, endA
+		if startB > start {
+			start = startB
+		}
+		if endB < end {
+			end = endB
+		}
+		if end-start > best {
+			best = end - start
+		}
+	}
+
+	return best
+}
@@ -0,0 +1,126 @@
+package countries_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/georgesafta/countries"
+)
+
+func TestParseOffset(t *testing.T) {
+	valid := map[string]time.Duration{
+		"UTC":       0,
+		"UTC+00:00": 0,
+		"UTC-05:00": -5 * time.Hour,
+		"UTC+05:45": 5*time.Hour + 45*time.Minute,
+		"UTC-03:30": -(3*time.Hour + 30*time.Minute),
+		"UTC+14:00": 14 * time.Hour,
+	}
+	for s, expected := range valid {
+		o, err := countries.ParseOffset(s)
+		if err != nil {
+			t.Fatalf("Unexpected error for %s: %v", s, err)
+		}
+		if time.Duration(o) != expected {
+			t.Fatalf("Expected %v for %s, got %v", expected, s, time.Duration(o))
+		}
+	}
+
+	invalid := map[string]string{
+		"GMT+01:00": `Invalid timezone "GMT+01:00": missing UTC prefix`,
+		"UTC 01:00": `Invalid timezone "UTC 01:00": missing offset sign`,
+		"UTC+1":     `Invalid timezone "UTC+1": offset must be formatted as ±HH:MM`,
+		"UTC+ab:00": `Invalid timezone "UTC+ab:00": invalid hours`,
+		"UTC+01:60": `Invalid timezone "UTC+01:60": invalid minutes`,
+		"UTC+-5:00": `Invalid timezone "UTC+-5:00": invalid hours`,
+		"UTC++5:00": `Invalid timezone "UTC++5:00": invalid hours`,
+		"UTC+05:-1": `Invalid timezone "UTC+05:-1": invalid minutes`,
+		"UTC-05:+3": `Invalid timezone "UTC-05:+3": invalid minutes`,
+		"UTC+15:00": `Invalid timezone "UTC+15:00": offset out of range`,
+	}
+	for s, expected := range invalid {
+		_, err := countries.ParseOffset(s)
+		if err == nil || err.Error() != expected {
+			t.Fatalf("Expected error %s, got %v", expected, err)
+		}
+	}
+}
+
+func TestOffsetString(t *testing.T) {
+	for _, s := range []string{"UTC", "UTC-05:00", "UTC+05:45", "UTC-09:30"} {
+		o, _ := countries.ParseOffset(s)
+		if o.String() != s {
+			t.Fatalf("Expected %s, got %s", s, o.String())
+		}
+	}
+}
+
+func TestLocalTimes(t *testing.T) {
+	nepal := countries.Country{Alpha3Code: "NPL", Timezones: []string{"UTC+05:45"}}
+	now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	times, err := nepal.LocalTimes(now)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(times) != 1 || times[0].Format("15:04 MST") != "05:45 UTC+05:45" || !times[0].Equal(now) {
+		t.Fatalf("Unexpected local times %v", times)
+	}
+
+	broken := countries.Country{Alpha3Code: "XXX", Timezones: []string{"UTC", "UTC+5"}}
+	_, err = broken.LocalTimes(now)
+	if err == nil || err.Error() != `Invalid timezone "UTC+5" for XXX: offset must be formatted as ±HH:MM` {
+		t.Fatalf("Expected timezone error, got %v", err)
+	}
+}
+
+func TestValidateTimezones(t *testing.T) {
+	data := append(loadDataset(t), countries.Country{Alpha3Code: "XXX", Timezones: []string{"UTC+5", "CET"}})
+	errs := countries.ValidateTimezones(data)
+	if len(errs) != 2 || errs[0].Country != "XXX" || errs[1].Value != "CET" {
+		t.Fatalf("Expected two errors for XXX, got %v", errs)
+	}
+}
+
+func TestInOffsetRange(t *testing.T) {
+	min, _ := countries.ParseOffset("UTC+05:00")
+	max, _ := countries.ParseOffset("UTC+06:00")
+
+	var codes []string
+	for _, c := range countries.InOffsetRange(loadDataset(t), min, max) {
+		codes = append(codes, c.Alpha3Code)
+	}
+	expected := []string{"GBR", "FRA", "NPL", "IND", "AUS", "ATA"}
+	if len(codes) != len(expected) {
+		t.Fatalf("Expected %v, got %v", expected, codes)
+	}
+	for i := range expected {
+		if codes[i] != expected[i] {
+			t.Fatalf("Expected %v, got %v", expected, codes)
+		}
+	}
+}
+
+func TestBusinessHoursOverlap(t *testing.T) {
+	office, _ := countries.ParseOffset("UTC+01:00")
+	overlaps := countries.BusinessHoursOverlap(loadDataset(t), office, countries.DefaultBusinessHours)
+
+	byCode := map[string]countries.HoursOverlap{}
+	for _, o := range overlaps {
+		byCode[o.Country.Alpha3Code] = o
+	}
+	if o := byCode["DEU"]; o.Overlap != 8*time.Hour {
+		t.Fatalf("Expected full overlap with DEU, got %v", o.Overlap)
+	}
+	if o := byCode["IND"]; o.Overlap != 3*time.Hour+30*time.Minute || o.Offset.String() != "UTC+05:30" {
+		t.Fatalf("Unexpected overlap with IND %v at %s", o.Overlap, o.Offset)
+	}
+	if o := byCode["COL"]; o.Overlap != 2*time.Hour {
+		t.Fatalf("Unexpected overlap with COL %v", o.Overlap)
+	}
+	if _, ok := byCode["JPN"]; ok {
+		t.Fatal("Expected no overlap with JPN")
+	}
+	if overlaps[0].Overlap != 8*time.Hour || overlaps[len(overlaps)-1].Overlap > overlaps[0].Overlap {
+		t.Fatal("Expected overlaps sorted longest first")
+	}
+}